@@ -0,0 +1,270 @@
+package iterator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"io"
+)
+
+// Archives
+
+// ErrEntryInvalidated is returned by the reader of an archive entry when it is read after Next advanced to the next
+// entry, or after the iterator is closed.
+var ErrEntryInvalidated = errors.New("iterator: archive entry read after Next")
+
+// entryReader is the reader of an archive entry. It is invalidated when the iterator advances, and records read
+// errors in the iterator, so corrupt entries show up in Error.
+type entryReader struct {
+	// r contains the reader of the entry
+	r io.Reader
+	// err points to the error of the iterator
+	err *error
+	// invalid is true when the iterator has advanced
+	invalid bool
+}
+
+// Read reads from the entry, or returns ErrEntryInvalidated when the iterator has advanced.
+func (e *entryReader) Read(p []byte) (int, error) {
+	if e.invalid {
+		return 0, ErrEntryInvalidated
+	}
+	n, err := e.r.Read(p)
+	if err != nil && err != io.EOF && *e.err == nil {
+		*e.err = err
+	}
+	return n, err
+}
+
+// invalidate invalidates the reader of the entry when there is one.
+func (e *entryReader) invalidate() {
+	if e != nil {
+		e.invalid = true
+	}
+}
+
+// TarEntry is an entry of a tar archive.
+type TarEntry struct {
+	// Header contains the header of the entry.
+	Header *tar.Header
+	// Reader reads the contents of the entry. The reader of an entry returned by FromTar is only valid until Next is
+	// called again.
+	Reader io.Reader
+}
+
+// TarArchiveIterator is a struct the implements an Iterable that returns the entries of a tar archive.
+type TarArchiveIterator struct {
+	// src contains the reader of the archive
+	src io.Reader
+	// gz contains the gzip reader when the archive is compressed
+	gz *gzip.Reader
+	// tr contains the reader of the tar archive
+	tr *tar.Reader
+	// current contains the reader of the last returned entry
+	current *entryReader
+	// done is true when the archive is completely read or an error occurred
+	done bool
+	// err contains the error that occurred during reading
+	err error
+}
+
+// Next returns the first or next entry and true if an entry is available.
+// If no more entries are available or an error has occurred then a zero TarEntry and false is returned.
+// The reader of the previous entry is invalidated.
+func (iter *TarArchiveIterator) Next() (TarEntry, bool) {
+	iter.current.invalidate()
+	iter.current = nil
+	if iter.done || iter.err != nil {
+		iter.done = true
+		return TarEntry{}, false
+	}
+	if iter.tr == nil && !iter.open() {
+		return TarEntry{}, false
+	}
+	hdr, err := iter.tr.Next()
+	if err != nil {
+		iter.done = true
+		if err != io.EOF {
+			iter.err = err
+		}
+		return TarEntry{}, false
+	}
+	iter.current = &entryReader{r: iter.tr, err: &iter.err}
+	return TarEntry{Header: hdr, Reader: iter.current}, true
+}
+
+// open creates the tar reader, with a gzip reader in between when the archive starts with the gzip magic number.
+func (iter *TarArchiveIterator) open() bool {
+	br := bufio.NewReader(iter.src)
+	var r io.Reader = br
+	if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			iter.done, iter.err = true, err
+			return false
+		}
+		iter.gz, r = gz, gz
+	}
+	iter.tr = tar.NewReader(r)
+	return true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. Errors of corrupt archives are returned, also when they occurred while reading an entry.
+func (iter *TarArchiveIterator) Error() error {
+	return iter.err
+}
+
+// Close invalidates the reader of the last returned entry and closes the gzip reader. After Close, Next returns
+// false. The reader of the archive is not closed.
+func (iter *TarArchiveIterator) Close() error {
+	iter.current.invalidate()
+	iter.current, iter.done = nil, true
+	if iter.gz != nil {
+		return iter.gz.Close()
+	}
+	return nil
+}
+
+// SizeHint returns exactly zero when the iteration is done, otherwise nothing is known about the number of entries.
+func (iter *TarArchiveIterator) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromTar accepts an io.Reader with a tar archive and returns a TarArchiveIterator that returns the entries of the
+// archive one by one, without extracting them. Archives compressed with gzip are decompressed transparently. The
+// reader of each entry is only valid until Next is called again, after that it returns ErrEntryInvalidated. The
+// reader of the archive is not closed, use OnClose to close it when the iteration is done.
+func FromTar(r io.Reader) *TarArchiveIterator {
+	return &TarArchiveIterator{src: r}
+}
+
+// ToTar writes the entries of the Iterable to the io.Writer as a tar archive. The contents of each entry are copied
+// from its Reader, which can be nil for entries without contents. When an entry cannot be written, or the Iterable
+// returns an error, the iteration stops and the error is returned without finishing the archive. The io.Writer is
+// not closed. The Iterable is closed when the iteration is done.
+func ToTar(iter Iterable[TarEntry], w io.Writer) error {
+	tw := tar.NewWriter(w)
+	for e, b := iter.Next(); b; e, b = iter.Next() {
+		err := tw.WriteHeader(e.Header)
+		if err == nil && e.Reader != nil {
+			_, err = io.Copy(tw, e.Reader)
+		}
+		if err != nil {
+			return join(err, Close(iter))
+		}
+	}
+	if err := Finish(iter); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// ZipEntry is an entry of a zip archive.
+type ZipEntry struct {
+	// Header contains the header of the entry.
+	Header *zip.FileHeader
+	// Reader reads the decompressed contents of the entry. The reader of an entry returned by FromZip is only valid
+	// until Next is called again.
+	Reader io.Reader
+}
+
+// ZipArchiveIterator is a struct the implements an Iterable that returns the entries of a zip archive.
+type ZipArchiveIterator struct {
+	// zr contains the zip archive
+	zr *zip.Reader
+	// idx contains the index of the next file
+	idx int
+	// current contains the reader of the last returned entry
+	current *entryReader
+	// rc contains the opened file of the last returned entry
+	rc io.ReadCloser
+	// done is true when all entries are returned or an error occurred
+	done bool
+	// err contains the error that occurred during reading
+	err error
+}
+
+// Next returns the first or next entry and true if an entry is available.
+// If no more entries are available or an error has occurred then a zero ZipEntry and false is returned.
+// The reader of the previous entry is invalidated.
+func (iter *ZipArchiveIterator) Next() (ZipEntry, bool) {
+	iter.closeCurrent()
+	if iter.done || iter.err != nil || iter.idx >= len(iter.zr.File) {
+		iter.done = true
+		return ZipEntry{}, false
+	}
+	f := iter.zr.File[iter.idx]
+	iter.idx++
+	rc, err := f.Open()
+	if err != nil {
+		iter.done, iter.err = true, err
+		return ZipEntry{}, false
+	}
+	iter.rc = rc
+	iter.current = &entryReader{r: rc, err: &iter.err}
+	return ZipEntry{Header: &f.FileHeader, Reader: iter.current}, true
+}
+
+// closeCurrent invalidates and closes the reader of the last returned entry.
+func (iter *ZipArchiveIterator) closeCurrent() {
+	iter.current.invalidate()
+	if iter.rc != nil {
+		_ = iter.rc.Close()
+	}
+	iter.current, iter.rc = nil, nil
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. Errors of corrupt entries are returned, also when they occurred while reading an entry.
+func (iter *ZipArchiveIterator) Error() error {
+	return iter.err
+}
+
+// Close invalidates and closes the reader of the last returned entry. After Close, Next returns false.
+func (iter *ZipArchiveIterator) Close() error {
+	iter.closeCurrent()
+	iter.done = true
+	return nil
+}
+
+// SizeHint returns the exact number of entries that remain, unless an error occurred.
+func (iter *ZipArchiveIterator) SizeHint() (int, int, bool) {
+	if iter.done || iter.err != nil {
+		return exactHint(0)
+	}
+	return exactHint(len(iter.zr.File) - iter.idx)
+}
+
+// FromZip accepts a zip.Reader and returns a ZipArchiveIterator that returns the entries of the archive one by one,
+// without extracting them. The reader of each entry is only valid until Next is called again, after that it returns
+// ErrEntryInvalidated.
+func FromZip(zr *zip.Reader) *ZipArchiveIterator {
+	return &ZipArchiveIterator{zr: zr}
+}
+
+// ToZip writes the entries of the Iterable to the io.Writer as a zip archive. The contents of each entry are copied
+// from its Reader, which can be nil for entries without contents, and compressed with the method in the header.
+// When an entry cannot be written, or the Iterable returns an error, the iteration stops and the error is returned
+// without finishing the archive. The io.Writer is not closed. The Iterable is closed when the iteration is done.
+func ToZip(iter Iterable[ZipEntry], w io.Writer) error {
+	zw := zip.NewWriter(w)
+	for e, b := iter.Next(); b; e, b = iter.Next() {
+		fw, err := zw.CreateHeader(e.Header)
+		if err == nil && e.Reader != nil {
+			_, err = io.Copy(fw, e.Reader)
+		}
+		if err != nil {
+			return join(err, Close(iter))
+		}
+	}
+	if err := Finish(iter); err != nil {
+		return err
+	}
+	return zw.Close()
+}
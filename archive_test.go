@@ -0,0 +1,339 @@
+package iterator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromTar() {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range []string{"README.md", "bin/tool", "bin/tool.sha256"} {
+		_ = tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(name))})
+		_, _ = tw.Write([]byte(name))
+	}
+	_ = tw.Close()
+
+	// Only keep the binaries, the readers of the entries are valid until Next is called again.
+	binaries := Filter[TarEntry](FromTar(&buf), func(e TarEntry) bool {
+		return path.Dir(e.Header.Name) == "bin"
+	})
+
+	_ = ForEach[TarEntry](binaries, func(e TarEntry) {
+		data, _ := io.ReadAll(e.Reader)
+		fmt.Printf("%s: %s\n", e.Header.Name, data)
+	})
+
+	// Output:
+	// bin/tool: bin/tool
+	// bin/tool.sha256: bin/tool.sha256
+}
+
+func ExampleToZip() {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	_ = tw.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0o644, Size: 5})
+	_, _ = tw.Write([]byte("hello"))
+	_ = tw.Close()
+
+	// Convert the tar archive to a zip archive without extracting it.
+	entries := Map[TarEntry, ZipEntry](FromTar(&buf), func(e TarEntry) ZipEntry {
+		hdr, _ := zip.FileInfoHeader(e.Header.FileInfo())
+		hdr.Name, hdr.Method = e.Header.Name, zip.Deflate
+		return ZipEntry{Header: hdr, Reader: e.Reader}
+	})
+
+	var out bytes.Buffer
+	if err := ToZip(entries, &out); err != nil {
+		fmt.Println(err)
+	}
+
+	zr, _ := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	for _, f := range zr.File {
+		fmt.Println(f.Name, f.UncompressedSize64)
+	}
+
+	// Output:
+	// hello.txt 5
+}
+
+// Tests
+
+type archiveFixture struct {
+	data    []byte
+	zip     bool
+	tarIter *TarArchiveIterator
+	zipIter *ZipArchiveIterator
+	entries Iterable[string]
+	readErr error
+	written []byte
+}
+
+var fa *archiveFixture
+
+func aTarArchiveWithTheFiles(table *godog.Table) error {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, row := range table.Rows {
+		name, content := row.Cells[0].Value, row.Cells[1].Value
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}); err != nil {
+			return err
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	fa.data, fa.zip = buf.Bytes(), false
+	return nil
+}
+
+func aZipArchiveWithTheFiles(table *godog.Table) error {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, row := range table.Rows {
+		// The files are stored, so their contents can be found and corrupted.
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: row.Cells[0].Value, Method: zip.Store})
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte(row.Cells[1].Value)); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	fa.data, fa.zip = buf.Bytes(), true
+	return nil
+}
+
+func theTarArchiveIsCompressedWithGzip() error {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(fa.data); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	fa.data = buf.Bytes()
+	return nil
+}
+
+func theArchiveIsTruncatedToBytes(n int) {
+	fa.data = fa.data[:n]
+}
+
+func theContentsOfTheZipEntryAreCorrupted(content string) error {
+	i := bytes.Index(fa.data, []byte(content))
+	if i < 0 {
+		return fmt.Errorf("content %q not found", content)
+	}
+	fa.data[i] ^= 0xff
+	return nil
+}
+
+func theLocalHeaderOfTheZipArchiveIsCorrupted() {
+	copy(fa.data, "XXXX")
+}
+
+// readEntry returns the name and contents of an entry as name=contents.
+func readEntry(name string, r io.Reader) string {
+	data, _ := io.ReadAll(r)
+	return name + "=" + string(data)
+}
+
+func fromTarIsCalled() {
+	fa.tarIter = FromTar(bytes.NewReader(fa.data))
+	fa.entries = Map[TarEntry, string](fa.tarIter, func(e TarEntry) string {
+		return readEntry(e.Header.Name, e.Reader)
+	})
+}
+
+func fromZipIsCalled() error {
+	zr, err := zip.NewReader(bytes.NewReader(fa.data), int64(len(fa.data)))
+	if err != nil {
+		return err
+	}
+	fa.zipIter = FromZip(zr)
+	fa.entries = Map[ZipEntry, string](fa.zipIter, func(e ZipEntry) string {
+		return readEntry(e.Header.Name, e.Reader)
+	})
+	return nil
+}
+
+func theReaderOfTheFirstTarEntryIsReadAfterNext() error {
+	iter := FromTar(bytes.NewReader(fa.data))
+	e, b := iter.Next()
+	if !b {
+		return fmt.Errorf("expected an entry")
+	}
+	iter.Next()
+	_, fa.readErr = io.ReadAll(e.Reader)
+	return nil
+}
+
+func theReaderOfTheFirstZipEntryIsReadAfterClose() error {
+	zr, err := zip.NewReader(bytes.NewReader(fa.data), int64(len(fa.data)))
+	if err != nil {
+		return err
+	}
+	iter := FromZip(zr)
+	e, b := iter.Next()
+	if !b {
+		return fmt.Errorf("expected an entry")
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	_, fa.readErr = io.ReadAll(e.Reader)
+	return nil
+}
+
+func readingTheEntryReturnsErrEntryInvalidated() error {
+	if !errors.Is(fa.readErr, ErrEntryInvalidated) {
+		return fmt.Errorf("expected: %v got: %v", ErrEntryInvalidated, fa.readErr)
+	}
+	return nil
+}
+
+func theEntriesEndingWithAreWrittenWithToTar(suffix string) error {
+	var buf bytes.Buffer
+	entries := Filter[TarEntry](FromTar(bytes.NewReader(fa.data)), func(e TarEntry) bool {
+		return strings.HasSuffix(e.Header.Name, suffix)
+	})
+	if err := ToTar(entries, &buf); err != nil {
+		return err
+	}
+	fa.written, fa.zip = buf.Bytes(), false
+	return nil
+}
+
+func theEntriesEndingWithAreWrittenWithToZip(suffix string) error {
+	zr, err := zip.NewReader(bytes.NewReader(fa.data), int64(len(fa.data)))
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	entries := Filter[ZipEntry](FromZip(zr), func(e ZipEntry) bool {
+		return strings.HasSuffix(e.Header.Name, suffix)
+	})
+	if err := ToZip(entries, &buf); err != nil {
+		return err
+	}
+	fa.written, fa.zip = buf.Bytes(), true
+	return nil
+}
+
+func theWrittenArchiveIsReadBack() error {
+	fa.data = fa.written
+	if fa.zip {
+		return fromZipIsCalled()
+	}
+	fromTarIsCalled()
+	return nil
+}
+
+func anEntryWithTheWrongSizeIsWrittenWithToTar() {
+	entries := FromSlice([]TarEntry{{
+		Header: &tar.Header{Name: "a.txt", Mode: 0o644, Size: 1},
+		Reader: strings.NewReader("too long"),
+	}})
+	fa.readErr = ToTar(entries, io.Discard)
+}
+
+func toTarReturnsErrWriteTooLong() error {
+	if !errors.Is(fa.readErr, tar.ErrWriteTooLong) {
+		return fmt.Errorf("expected: %v got: %v", tar.ErrWriteTooLong, fa.readErr)
+	}
+	return nil
+}
+
+func theArchiveEntriesAre(expected string) error {
+	var entries []string
+	for e, b := fa.entries.Next(); b; e, b = fa.entries.Next() {
+		entries = append(entries, e)
+	}
+	if got := strings.Join(entries, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theArchiveEntryNamesAre(expected string) error {
+	var names []string
+	for e, b := fa.entries.Next(); b; e, b = fa.entries.Next() {
+		names = append(names, e[:strings.Index(e, "=")])
+	}
+	if got := strings.Join(names, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func errorOfTheArchiveReturnsNil() error {
+	if err := fa.entries.Error(); err != nil {
+		return fmt.Errorf("expected nil got: %v", err)
+	}
+	return nil
+}
+
+func errorOfTheArchiveReturns(expected string) error {
+	errs := map[string]error{
+		"io.ErrUnexpectedEOF": io.ErrUnexpectedEOF,
+		"zip.ErrChecksum":     zip.ErrChecksum,
+		"zip.ErrFormat":       zip.ErrFormat,
+		"gzip.ErrHeader":      gzip.ErrHeader,
+	}
+	if err := fa.entries.Error(); !errors.Is(err, errs[expected]) {
+		return fmt.Errorf("expected: %v got: %v", expected, err)
+	}
+	return nil
+}
+
+func sizeHintOfTheZipArchiveIteratorReturns(lower, upper int, exact string) error {
+	l, u, e := fa.zipIter.SizeHint()
+	if l != lower || u != upper || fmt.Sprint(e) != exact {
+		return fmt.Errorf("expected: %v, %v, %v got: %v, %v, %v", lower, upper, exact, l, u, e)
+	}
+	return nil
+}
+
+func initializeArchiveScenario(ctx *godog.ScenarioContext) {
+	fa = &archiveFixture{}
+	ctx.Step(`^a tar archive with the files:$`, aTarArchiveWithTheFiles)
+	ctx.Step(`^a zip archive with the files:$`, aZipArchiveWithTheFiles)
+	ctx.Step(`^the tar archive is compressed with gzip$`, theTarArchiveIsCompressedWithGzip)
+	ctx.Step(`^the archive is truncated to (\d+) bytes$`, theArchiveIsTruncatedToBytes)
+	ctx.Step(`^the contents "([^"]*)" of the zip entry are corrupted$`, theContentsOfTheZipEntryAreCorrupted)
+	ctx.Step(`^the local header of the zip archive is corrupted$`, theLocalHeaderOfTheZipArchiveIsCorrupted)
+	ctx.Step(`^FromTar is called$`, fromTarIsCalled)
+	ctx.Step(`^FromZip is called$`, fromZipIsCalled)
+	ctx.Step(`^the reader of the first tar entry is read after Next$`, theReaderOfTheFirstTarEntryIsReadAfterNext)
+	ctx.Step(`^the reader of the first zip entry is read after Close$`, theReaderOfTheFirstZipEntryIsReadAfterClose)
+	ctx.Step(`^reading the entry returns ErrEntryInvalidated$`, readingTheEntryReturnsErrEntryInvalidated)
+	ctx.Step(`^the entries ending with "([^"]*)" are written with ToTar$`, theEntriesEndingWithAreWrittenWithToTar)
+	ctx.Step(`^the entries ending with "([^"]*)" are written with ToZip$`, theEntriesEndingWithAreWrittenWithToZip)
+	ctx.Step(`^the written archive is read back$`, theWrittenArchiveIsReadBack)
+	ctx.Step(`^an entry with the wrong size is written with ToTar$`, anEntryWithTheWrongSizeIsWrittenWithToTar)
+	ctx.Step(`^ToTar returns tar.ErrWriteTooLong$`, toTarReturnsErrWriteTooLong)
+	ctx.Step(`^the archive entries are: "([^"]*)"$`, theArchiveEntriesAre)
+	ctx.Step(`^the archive entry names are: "([^"]*)"$`, theArchiveEntryNamesAre)
+	ctx.Step(`^Error\(\) of the archive returns nil$`, errorOfTheArchiveReturnsNil)
+	ctx.Step(`^Error\(\) of the archive returns ([\w.]+)$`, errorOfTheArchiveReturns)
+	ctx.Step(`^SizeHint of the zip archive iterator returns (\d+), (-?\d+) and (true|false)$`, sizeHintOfTheZipArchiveIteratorReturns)
+}
@@ -0,0 +1,262 @@
+package iterator
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Chunk
+
+// ChunkIterator is a struct the implements an Iterable that groups the values of the source Iterable in slices.
+type ChunkIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// n contains the maximum number of values in a chunk.
+	n int
+}
+
+// Next returns the first or next chunk of at most n values and true if a chunk is available.
+// Only the last chunk can contain less than n values. Each chunk is a newly allocated slice.
+// If no more values are available or an error has occurred then nil and false is returned.
+func (iter *ChunkIterator[T]) Next() ([]T, bool) {
+	var chunk []T
+	for len(chunk) < iter.n {
+		v, b := iter.srcItr.Next()
+		if !b {
+			break
+		}
+		if chunk == nil {
+			n := iter.n
+			if _, upper, _ := SizeHint(iter.srcItr); upper >= 0 && upper < n-1 {
+				n = upper + 1
+			}
+			chunk = make([]T, 0, n)
+		}
+		chunk = append(chunk, v)
+	}
+	return chunk, chunk != nil
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *ChunkIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *ChunkIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the number of chunks needed for the size hint of the source Iterable.
+func (iter *ChunkIterator[T]) SizeHint() (int, int, bool) {
+	lower, upper, _ := SizeHint(iter.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		return ceilDiv(n, iter.n)
+	})
+}
+
+// Chunk accepts an Iterable and a chunk size and creates a ChunkIterator that returns the values of the provided
+// Iterable in chunks of n values. A chunk size smaller than 1 is treated as 1. When the SizeHint of the provided
+// Iterable has an upper bound smaller than n, chunks are allocated with that smaller capacity.
+func Chunk[T any](iter Iterable[T], n int) *ChunkIterator[T] {
+	if n < 1 {
+		n = 1
+	}
+	return &ChunkIterator[T]{
+		srcItr: iter,
+		n:      n,
+	}
+}
+
+// Batch
+
+// BatchIterator is a struct the implements an Iterable that groups the values received from a channel in slices,
+// limited by a size and a duration.
+type BatchIterator[T any] struct {
+	// c contains the channel to receive the values from.
+	c <-chan T
+	// size contains the maximum number of values in a batch.
+	size int
+	// d contains the maximum duration between receiving the first value of a batch and returning the batch.
+	d time.Duration
+	// ctx contains the context that stops the iteration when it is done.
+	ctx context.Context
+	// err contains the error of ctx after the iteration was stopped by it.
+	err error
+	// done contains true when the channel is closed, the context is done or Close is called.
+	done bool
+}
+
+// Next returns the first or next batch of values and true if a batch is available.
+// A batch is returned as soon as it contains size values, or when the duration has elapsed since the first value of
+// the batch was received, whichever comes first. A partial batch is returned when the channel is closed or the
+// context is done. Each batch is a newly allocated slice.
+// If no more values are available or an error has occurred then nil and false is returned.
+func (iter *BatchIterator[T]) Next() ([]T, bool) {
+	if iter.done {
+		return nil, false
+	}
+	var batch []T
+	var timeout <-chan time.Time
+	for {
+		select {
+		case v, ok := <-iter.c:
+			if !ok {
+				iter.done = true
+				return batch, batch != nil
+			}
+			if batch == nil {
+				batch = make([]T, 0, iter.size)
+				timer := time.NewTimer(iter.d)
+				defer timer.Stop()
+				timeout = timer.C
+			}
+			batch = append(batch, v)
+			if len(batch) == iter.size {
+				return batch, true
+			}
+		case <-timeout:
+			return batch, true
+		case <-iter.ctx.Done():
+			iter.err = iter.ctx.Err()
+			iter.done = true
+			return batch, batch != nil
+		}
+	}
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The BatchIterator only returns an error when its context is done.
+func (iter *BatchIterator[T]) Error() error {
+	return iter.err
+}
+
+// Close stops the iteration, Next returns false after Close is called. The channel itself is not closed, because
+// only the sender of a channel should close it.
+func (iter *BatchIterator[T]) Close() error {
+	iter.done = true
+	return nil
+}
+
+// SizeHint returns exactly zero when the iteration is done, otherwise nothing is known about the number of batches.
+func (iter *BatchIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// Batch accepts a channel, a batch size and a duration and creates a BatchIterator that returns the values received
+// from the channel in batches that are flushed when they reach the size or when the duration has elapsed since
+// their first value, whichever comes first. A batch size smaller than 1 is treated as 1.
+func Batch[T any](c <-chan T, size int, d time.Duration) *BatchIterator[T] {
+	return BatchContext(context.Background(), c, size, d)
+}
+
+// BatchContext is like Batch, but stops the iteration when the provided context is done. The values received so far
+// are returned as a last partial batch and Error returns the error of the context.
+func BatchContext[T any](ctx context.Context, c <-chan T, size int, d time.Duration) *BatchIterator[T] {
+	if size < 1 {
+		size = 1
+	}
+	return &BatchIterator[T]{
+		c:    c,
+		size: size,
+		d:    d,
+		ctx:  ctx,
+	}
+}
+
+// Window
+
+// WindowIterator is a struct the implements an Iterable that returns sliding windows over the values of the source
+// Iterable.
+type WindowIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// size contains the number of values in a window.
+	size int
+	// step contains the number of values the window slides with each iteration.
+	step int
+	// buf contains the current window.
+	buf []T
+	// shared contains true when buf itself is returned instead of a copy of it.
+	shared bool
+}
+
+// Next returns the first or next window and true if a window is available.
+// Only complete windows are returned, values at the end of the source Iterable that do not fill a window are
+// discarded.
+// If no more values are available or an error has occurred then nil and false is returned.
+func (iter *WindowIterator[T]) Next() ([]T, bool) {
+	keep := 0
+	if iter.buf == nil {
+		iter.buf = make([]T, iter.size)
+	} else {
+		keep = max(iter.size-iter.step, 0)
+		for n := iter.size; n < iter.step; n++ {
+			if _, b := iter.srcItr.Next(); !b {
+				return nil, false
+			}
+		}
+		copy(iter.buf, iter.buf[iter.size-keep:])
+	}
+	for n := keep; n < iter.size; n++ {
+		v, b := iter.srcItr.Next()
+		if !b {
+			return nil, false
+		}
+		iter.buf[n] = v
+	}
+	if iter.shared {
+		return iter.buf, true
+	}
+	return slices.Clone(iter.buf), true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *WindowIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *WindowIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the number of complete windows that fit in the size hint of the source Iterable.
+func (iter *WindowIterator[T]) SizeHint() (int, int, bool) {
+	lower, upper, _ := SizeHint(iter.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		if iter.buf != nil {
+			return n / iter.step
+		}
+		if n < iter.size {
+			return 0
+		}
+		return (n-iter.size)/iter.step + 1
+	})
+}
+
+// Window accepts an Iterable, a window size and a step and creates a WindowIterator that returns windows of size
+// values, each window starts step values after the start of the previous window. When step is larger than size the
+// values between the windows are discarded. Each window is a newly allocated slice, so windows can be kept and
+// modified. A size or step smaller than 1 is treated as 1.
+func Window[T any](iter Iterable[T], size int, step int) *WindowIterator[T] {
+	return &WindowIterator[T]{
+		srcItr: iter,
+		size:   max(size, 1),
+		step:   max(step, 1),
+	}
+}
+
+// WindowShared is like Window, but returns the same buffer for every window to avoid an allocation per window.
+// A window is only valid until the next call to Next and must not be modified, copy it when it needs to be kept.
+func WindowShared[T any](iter Iterable[T], size int, step int) *WindowIterator[T] {
+	wi := Window(iter, size, step)
+	wi.shared = true
+	return wi
+}
@@ -0,0 +1,181 @@
+package iterator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleChunk() {
+	// Chunk groups the values, for example to write them in batches.
+	ci := Chunk[int](Sequence(1, 7), 3)
+
+	_ = ForEach[[]int](ci, func(v []int) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// [1 2 3]
+	// [4 5 6]
+	// [7]
+}
+
+func ExampleWindow() {
+	// Window returns the last 3 values with each step.
+	wi := Window[int](Sequence(1, 5), 3, 1)
+
+	_ = ForEach[[]int](wi, func(v []int) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// [1 2 3]
+	// [2 3 4]
+	// [3 4 5]
+}
+
+// Tests
+
+type chunkFixture struct {
+	slices   Iterable[[]int]
+	returned [][]int
+}
+
+var ck chunkFixture
+
+func chunkIsCalledWith(n int) {
+	ck.slices = Chunk(t.resultingIntIterator, n)
+}
+
+func batchIsCalledWithSizeAndADurationOfHour(size int) {
+	ck.slices = Batch(t.channel, size, time.Hour)
+}
+
+func batchIsCalledWithSizeAndADurationOfMilliseconds(size, ms int) {
+	ck.slices = Batch(t.channel, size, time.Duration(ms)*time.Millisecond)
+}
+
+func batchContextIsCalledWithSizeAndADurationOfHour(size int) {
+	ck.slices = BatchContext(cx.ctx, t.channel, size, time.Hour)
+}
+
+func aChannelThatReceivesTheValueAndStaysOpen(v int) {
+	t.channel = make(chan int, 1)
+	t.channel <- v
+}
+
+func theContextIsCancelledAfterMilliseconds(ms int) {
+	time.AfterFunc(time.Duration(ms)*time.Millisecond, cx.cancel)
+}
+
+func windowIsCalledWithSizeAndStep(size, step int) {
+	ck.slices = Window(t.resultingIntIterator, size, step)
+}
+
+func windowSharedIsCalledWithSizeAndStep(size, step int) {
+	ck.slices = WindowShared(t.resultingIntIterator, size, step)
+}
+
+func theWindowsAreSummedWithMap() {
+	t.resultingIntIterator = Map(ck.slices, func(v []int) int {
+		sum := 0
+		for _, i := range v {
+			sum += i
+		}
+		return sum
+	})
+}
+
+func formatSlices(in [][]int) string {
+	var parts []string
+	for _, s := range in {
+		var values []string
+		for _, v := range s {
+			values = append(values, strconv.Itoa(v))
+		}
+		parts = append(parts, strings.Join(values, ","))
+	}
+	return strings.Join(parts, "|")
+}
+
+func theReturnedSlicesAre(expected string) error {
+	ck.returned = nil
+	var results [][]int
+	for v, b := ck.slices.Next(); b; v, b = ck.slices.Next() {
+		ck.returned = append(ck.returned, v)
+		results = append(results, slices.Clone(v))
+	}
+	if got := formatSlices(results); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theNextSliceIs(expected string) error {
+	v, b := ck.slices.Next()
+	if !b {
+		return errors.New("expected: true got: false")
+	}
+	if got := formatSlices([][]int{v}); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func allReturnedSlicesShareTheSameBuffer() error {
+	for _, s := range ck.returned[1:] {
+		if &s[0] != &ck.returned[0][0] {
+			return errors.New("expected the slices to share the same buffer")
+		}
+	}
+	return nil
+}
+
+func errorOfTheSliceIteratorReturnsAnError() error {
+	if ck.slices.Error() == nil {
+		return errors.New("expected an error but got nil")
+	}
+	return nil
+}
+
+func errorOfTheSliceIteratorReturnsNil() error {
+	if err := ck.slices.Error(); err != nil {
+		return fmt.Errorf("expected nil but got: %v", err)
+	}
+	return nil
+}
+
+func errorOfTheSliceIteratorReturnsTheContextError() error {
+	if err := ck.slices.Error(); !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("expected: %v got: %v", context.Canceled, err)
+	}
+	return nil
+}
+
+func initializeChunkScenario(ctx *godog.ScenarioContext) {
+	ck = chunkFixture{}
+
+	ctx.Step(`^Chunk is called with (\d+)$`, chunkIsCalledWith)
+	ctx.Step(`^Batch is called with size (\d+) and a duration of 1 hour$`, batchIsCalledWithSizeAndADurationOfHour)
+	ctx.Step(`^Batch is called with size (\d+) and a duration of (\d+) milliseconds$`, batchIsCalledWithSizeAndADurationOfMilliseconds)
+	ctx.Step(`^BatchContext is called with size (\d+) and a duration of 1 hour$`, batchContextIsCalledWithSizeAndADurationOfHour)
+	ctx.Step(`^a channel that receives the value (\d+) and stays open$`, aChannelThatReceivesTheValueAndStaysOpen)
+	ctx.Step(`^the context is cancelled after (\d+) milliseconds$`, theContextIsCancelledAfterMilliseconds)
+	ctx.Step(`^Window is called with size (\d+) and step (\d+)$`, windowIsCalledWithSizeAndStep)
+	ctx.Step(`^WindowShared is called with size (\d+) and step (\d+)$`, windowSharedIsCalledWithSizeAndStep)
+	ctx.Step(`^the windows are summed with Map$`, theWindowsAreSummedWithMap)
+	ctx.Step(`^the returned slices are: "([^"]*)"$`, theReturnedSlicesAre)
+	ctx.Step(`^the next slice is: "([^"]*)"$`, theNextSliceIs)
+	ctx.Step(`^all returned slices share the same buffer$`, allReturnedSlicesShareTheSameBuffer)
+	ctx.Step(`^Error\(\) of the slice iterator returns an error$`, errorOfTheSliceIteratorReturnsAnError)
+	ctx.Step(`^Error\(\) of the slice iterator returns nil$`, errorOfTheSliceIteratorReturnsNil)
+	ctx.Step(`^Error\(\) of the slice iterator returns the context error$`, errorOfTheSliceIteratorReturnsTheContextError)
+}
@@ -0,0 +1,105 @@
+package iterator
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// Close
+
+// Close closes the provided Iterable when it implements io.Closer, otherwise nil is returned.
+func Close[T any](iter Iterable[T]) error {
+	if c, ok := iter.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// closeAll closes all provided Iterables that implement io.Closer and joins the errors.
+func closeAll(iters ...any) error {
+	var err error
+	for _, iter := range iters {
+		if c, ok := iter.(io.Closer); ok {
+			err = join(err, c.Close())
+		}
+	}
+	return err
+}
+
+// join joins err with closeErr. Unlike errors.Join, a single error is returned as is, so it can still be compared
+// with ==.
+func join(err error, closeErr error) error {
+	if closeErr == nil {
+		return err
+	}
+	if err == nil {
+		return closeErr
+	}
+	return errors.Join(err, closeErr)
+}
+
+// Finish closes the provided Iterable and returns the error of the iteration joined with the error of closing it.
+// The terminal operations, like ForEach and ToSlice, call Finish when they are done.
+func Finish[T any](iter Iterable[T]) error {
+	return join(iter.Error(), Close(iter))
+}
+
+// CloserIterator is a struct the implements an Iterable that runs a close function when it is closed.
+type CloserIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// closeFunc contains the closure that releases the resources of the iteration.
+	closeFunc func() error
+	// once makes sure closeFunc is only called once.
+	once sync.Once
+	// closed contains true after Close is called.
+	closed bool
+	// err contains the error returned by the first call to Close.
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If the iterator is closed, no more values are available or an error has occurred then a zero value of T and false
+// is returned.
+func (iter *CloserIterator[T]) Next() (T, bool) {
+	if iter.closed {
+		var t T
+		return t, false
+	}
+	return iter.srcItr.Next()
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *CloserIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close calls the close function and closes the source Iterable. Only the first call has effect, later calls return
+// the same error.
+func (iter *CloserIterator[T]) Close() error {
+	iter.once.Do(func() {
+		iter.closed = true
+		iter.err = join(iter.closeFunc(), Close(iter.srcItr))
+	})
+	return iter.err
+}
+
+// SizeHint returns the size hint of the source Iterable.
+func (iter *CloserIterator[T]) SizeHint() (int, int, bool) {
+	if iter.closed {
+		return exactHint(0)
+	}
+	return SizeHint(iter.srcItr)
+}
+
+// OnClose accepts an Iterable and a close function and creates a CloserIterator that returns the values of the
+// provided Iterable and calls the close function once when it is closed, for example to stop a goroutine that
+// produces the values, or to close a file or database rows.
+func OnClose[T any](iter Iterable[T], f func() error) *CloserIterator[T] {
+	return &CloserIterator[T]{
+		srcItr:    iter,
+		closeFunc: f,
+	}
+}
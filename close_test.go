@@ -0,0 +1,273 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleOnClose() {
+	c := make(chan int)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	// The producer stops when done is closed, so it does not leak when the consumer stops early.
+	go func() {
+		defer close(stopped)
+		defer close(c)
+		for i := 1; ; i++ {
+			select {
+			case c <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// OnClose closes done when the iterator is closed.
+	ci := OnClose[int](FromChannel(c), func() error {
+		close(done)
+		return nil
+	})
+
+	// First stops after the first value and closes the iterator, and with it the producer.
+	v, _, _ := First[int](ci)
+	<-stopped
+
+	fmt.Println(v)
+
+	// Output:
+	// 1
+}
+
+// Tests
+
+var errClose = errors.New("close failed")
+
+// closableIterator is an Iterable that records how many values are pulled and if it is closed.
+type closableIterator struct {
+	Iterable[int]
+	pulled   int
+	closed   bool
+	closes   int
+	closeErr error
+}
+
+func (c *closableIterator) Next() (int, bool) {
+	v, b := c.Iterable.Next()
+	if b {
+		c.pulled++
+	}
+	return v, b
+}
+
+func (c *closableIterator) Close() error {
+	c.closed = true
+	c.closes++
+	return c.closeErr
+}
+
+type closeFixture struct {
+	closable *closableIterator
+	another  *closableIterator
+	pipeline Iterable[int]
+	inners   []*closableIterator
+	calls    int
+	err      error
+}
+
+var cs closeFixture
+
+func aClosableIterableWithTheFollowingValues(table *godog.Table) error {
+	s, err := toSliceOfInts(table)
+	cs.closable = &closableIterator{Iterable: FromSlice(s)}
+	return err
+}
+
+func anotherClosableIterableWithTheFollowingValues(table *godog.Table) error {
+	s, err := toSliceOfInts(table)
+	cs.another = &closableIterator{Iterable: FromSlice(s)}
+	return err
+}
+
+func aClosableIterableInAnErrorStateThatFailsToClose() {
+	cs.closable = &closableIterator{Iterable: &ErrorIterator[int]{}, closeErr: errClose}
+}
+
+func isCalledOnTheClosableIterable(operation string) error {
+	var it Iterable[int] = cs.closable
+	switch operation {
+	case "ForEach":
+		cs.err = ForEach(it, func(int) {})
+	case "Reduce":
+		_, cs.err = Reduce(it, 0, sum)
+	case "ToSlice":
+		_, cs.err = ToSlice(it)
+	case "ToChannel":
+		c := make(chan int, 3)
+		cs.err = ToChannel(it, c)
+	case "Collect":
+		_, cs.err = Collect(it, Counting[int]())
+	case "Sum":
+		_, cs.err = Sum(it)
+	case "Find":
+		_, _, cs.err = Find(it, greaterThan(1))
+	case "Finish":
+		cs.err = Finish(it)
+	default:
+		return fmt.Errorf("unknown operation: %s", operation)
+	}
+	return nil
+}
+
+func theClosableIterableIsClosed() error {
+	if !cs.closable.closed {
+		return errors.New("expected the Iterable to be closed")
+	}
+	return nil
+}
+
+func bothClosableIterablesAreClosed() error {
+	if !cs.closable.closed || !cs.another.closed {
+		return errors.New("expected both Iterables to be closed")
+	}
+	return nil
+}
+
+func theClosableIterableReturnedValues(n int) error {
+	if cs.closable.pulled != n {
+		return fmt.Errorf("expected: %v got: %v", n, cs.closable.pulled)
+	}
+	return nil
+}
+
+func aPipelineOfFilterMapTakeChunkAndPeekableIsCreatedOnTheClosableIterable() {
+	fi := Filter[int](cs.closable, greaterThan(0))
+	mi := Map[int, int](fi, func(v int) int {
+		return v * 2
+	})
+	ci := Chunk[int](Take[int](mi, 2), 2)
+	cs.pipeline = Map[[]int, int](Peekable[[]int](ci), func(v []int) int {
+		return len(v)
+	})
+}
+
+func thePipelineIsClosed() error {
+	return Close(cs.pipeline)
+}
+
+func concatIsCalledWithBothClosableIterablesAndClosed() error {
+	return Close[int](Concat[int](cs.closable, cs.another))
+}
+
+func zipIsCalledWithBothClosableIterablesAndClosed() error {
+	return Close[Pair[int, int]](Zip[int, int](cs.closable, cs.another))
+}
+
+func onCloseIsCalledWithACloseFunctionThatCountsTheCalls() {
+	cs.pipeline = OnClose[int](cs.closable, func() error {
+		cs.calls++
+		return nil
+	})
+}
+
+func theCloseFunctionIsCalledTimes(n int) error {
+	if cs.calls != n {
+		return fmt.Errorf("expected: %v got: %v", n, cs.calls)
+	}
+	return nil
+}
+
+func theReturnedErrorContainsTheIterationErrorAndTheCloseError() error {
+	if !errors.Is(cs.err, errClose) || cs.err.Error() == errClose.Error() {
+		return fmt.Errorf("expected the iteration error and the close error got: %v", cs.err)
+	}
+	return nil
+}
+
+func isCalledWithClosableIterablesAnd(combinator string, n int, operation string) error {
+	combined, err := combineClosableIterables(combinator, n)
+	if err != nil {
+		return err
+	}
+	switch operation {
+	case "ToSlice":
+		_, cs.err = ToSlice[int](combined)
+	case "First":
+		_, _, cs.err = First[int](combined)
+	default:
+		return fmt.Errorf("unknown operation: %s", operation)
+	}
+	return cs.err
+}
+
+func isCalledWithClosableIterablesAndNextIsCalledTimes(combinator string, n int, times int) error {
+	combined, err := combineClosableIterables(combinator, n)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < times; i++ {
+		combined.Next()
+	}
+	return combined.Error()
+}
+
+func combineClosableIterables(combinator string, n int) (Iterable[int], error) {
+	var inners []Iterable[int]
+	cs.inners = nil
+	for i := 0; i < n; i++ {
+		inner := &closableIterator{Iterable: FromSlice([]int{i, i})}
+		cs.inners = append(cs.inners, inner)
+		inners = append(inners, inner)
+	}
+	switch combinator {
+	case "Flatten":
+		return Flatten[int](FromSlice(inners)), nil
+	case "Concat":
+		return Concat[int](inners...), nil
+	default:
+		return nil, fmt.Errorf("unknown combinator: %s", combinator)
+	}
+}
+
+func theInnerIterablesAreClosedTimes(expected string) error {
+	var closes []string
+	for _, inner := range cs.inners {
+		closes = append(closes, fmt.Sprint(inner.closes))
+	}
+	if got := strings.Join(closes, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theChannelIteratorIsClosed() error {
+	return Close(t.resultingIntIterator)
+}
+
+func initializeCloseScenario(ctx *godog.ScenarioContext) {
+	cs = closeFixture{}
+
+	ctx.Step(`^a closable Iterable with the following values:$`, aClosableIterableWithTheFollowingValues)
+	ctx.Step(`^another closable Iterable with the following values:$`, anotherClosableIterableWithTheFollowingValues)
+	ctx.Step(`^a closable Iterable in an error state that fails to close$`, aClosableIterableInAnErrorStateThatFailsToClose)
+	ctx.Step(`^(\w+) is called on the closable Iterable$`, isCalledOnTheClosableIterable)
+	ctx.Step(`^the closable Iterable is closed$`, theClosableIterableIsClosed)
+	ctx.Step(`^both closable Iterables are closed$`, bothClosableIterablesAreClosed)
+	ctx.Step(`^the closable Iterable returned (\d+) values$`, theClosableIterableReturnedValues)
+	ctx.Step(`^a pipeline of Filter, Map, Take, Chunk and Peekable is created on the closable Iterable$`, aPipelineOfFilterMapTakeChunkAndPeekableIsCreatedOnTheClosableIterable)
+	ctx.Step(`^the pipeline is closed$`, thePipelineIsClosed)
+	ctx.Step(`^Concat is called with both closable Iterables and closed$`, concatIsCalledWithBothClosableIterablesAndClosed)
+	ctx.Step(`^Zip is called with both closable Iterables and closed$`, zipIsCalledWithBothClosableIterablesAndClosed)
+	ctx.Step(`^OnClose is called with a close function that counts the calls$`, onCloseIsCalledWithACloseFunctionThatCountsTheCalls)
+	ctx.Step(`^the close function is called (\d+) times$`, theCloseFunctionIsCalledTimes)
+	ctx.Step(`^the returned error contains the iteration error and the close error$`, theReturnedErrorContainsTheIterationErrorAndTheCloseError)
+	ctx.Step(`^(Flatten|Concat) is called with (\d+) closable Iterables and (\w+) is called$`, isCalledWithClosableIterablesAnd)
+	ctx.Step(`^(Flatten|Concat) is called with (\d+) closable Iterables and Next\(\) is called (\d+) times$`, isCalledWithClosableIterablesAndNextIsCalledTimes)
+	ctx.Step(`^the inner Iterables are closed "([^"]*)" times$`, theInnerIterablesAreClosedTimes)
+	ctx.Step(`^the ChannelIterator is closed$`, theChannelIteratorIsClosed)
+}
@@ -0,0 +1,180 @@
+package iterator
+
+// Collectors
+
+// Collector describes how Collect accumulates the values of an Iterable in a container of type A and turns the
+// container into a result of type R. Collectors can be nested, for example GroupBy collects the values of each group
+// with a downstream Collector.
+type Collector[T any, A any, R any] struct {
+	// Supply returns a new empty container. The size is the lower bound of the number of values that will be
+	// accumulated, Collectors can use it to preallocate the container.
+	Supply func(size int) A
+	// Accumulate adds a value to the container and returns the container.
+	Accumulate func(A, T) A
+	// Finish turns the container into the result.
+	Finish func(A) R
+}
+
+// Collect accepts an Iterable and a Collector and collects all values of the Iterable with the Collector.
+// The result of the values collected so far and the error that occurred during iteration are returned.
+// The Iterable is closed when the iteration is done.
+func Collect[T any, A any, R any](iter Iterable[T], c Collector[T, A, R]) (R, error) {
+	lower, _, _ := SizeHint(iter)
+	a := c.Supply(lower)
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		a = c.Accumulate(a, v)
+	}
+	return c.Finish(a), Finish(iter)
+}
+
+// identity returns the provided value, it is used as Finish function by Collectors that have no finishing step.
+func identity[T any](v T) T {
+	return v
+}
+
+// Appending returns a Collector that appends the values to a slice.
+func Appending[T any]() Collector[T, []T, []T] {
+	return Collector[T, []T, []T]{
+		Supply: func(size int) []T {
+			if size <= 0 {
+				return nil
+			}
+			return make([]T, 0, size)
+		},
+		Accumulate: func(a []T, v T) []T {
+			return append(a, v)
+		},
+		Finish: identity[[]T],
+	}
+}
+
+// Counting returns a Collector that counts the values.
+func Counting[T any]() Collector[T, int, int] {
+	return Collector[T, int, int]{
+		Supply: func(int) int {
+			return 0
+		},
+		Accumulate: func(a int, _ T) int {
+			return a + 1
+		},
+		Finish: identity[int],
+	}
+}
+
+// Reducing returns a Collector that reduces the values to a single value by calling the ReduceFunc closure,
+// starting with the init value.
+func Reducing[T any, R any](init R, reducer ReduceFunc[T, R]) Collector[T, R, R] {
+	return Collector[T, R, R]{
+		Supply: func(int) R {
+			return init
+		},
+		Accumulate: reducer,
+		Finish:     identity[R],
+	}
+}
+
+// Mapping returns a Collector that transforms each value with the MapFunc closure before it is collected by the
+// downstream Collector.
+func Mapping[T any, U any, A any, R any](f MapFunc[T, U], downstream Collector[U, A, R]) Collector[T, A, R] {
+	return Collector[T, A, R]{
+		Supply: downstream.Supply,
+		Accumulate: func(a A, v T) A {
+			return downstream.Accumulate(a, f(v))
+		},
+		Finish: downstream.Finish,
+	}
+}
+
+// ToSet returns a Collector that collects the distinct values in a map used as a set.
+func ToSet[T comparable]() Collector[T, map[T]struct{}, map[T]struct{}] {
+	return Collector[T, map[T]struct{}, map[T]struct{}]{
+		Supply: func(size int) map[T]struct{} {
+			return make(map[T]struct{}, size)
+		},
+		Accumulate: func(a map[T]struct{}, v T) map[T]struct{} {
+			a[v] = struct{}{}
+			return a
+		},
+		Finish: identity[map[T]struct{}],
+	}
+}
+
+// MergeFunc is the closure type that is provided to ToMap to merge the value that is already in the map with a new
+// value for the same key.
+type MergeFunc[V any] func(existing V, value V) V
+
+// ToMap returns a Collector that collects the values in a map. The key and the value of each entry are returned by
+// the key and val closures. When two values have the same key, the MergeFunc closure determines the value that is
+// kept. When merge is nil the last value wins.
+func ToMap[T any, K comparable, V any](key MapFunc[T, K], val MapFunc[T, V], merge MergeFunc[V]) Collector[T, map[K]V, map[K]V] {
+	return Collector[T, map[K]V, map[K]V]{
+		Supply: func(size int) map[K]V {
+			return make(map[K]V, size)
+		},
+		Accumulate: func(a map[K]V, v T) map[K]V {
+			k, nv := key(v), val(v)
+			if ev, ok := a[k]; ok && merge != nil {
+				nv = merge(ev, nv)
+			}
+			a[k] = nv
+			return a
+		},
+		Finish: identity[map[K]V],
+	}
+}
+
+// GroupBy returns a Collector that groups the values by the key returned by the key closure, and collects the
+// values of each group with the downstream Collector.
+func GroupBy[T any, K comparable, A any, R any](key MapFunc[T, K], downstream Collector[T, A, R]) Collector[T, map[K]A, map[K]R] {
+	return Collector[T, map[K]A, map[K]R]{
+		Supply: func(int) map[K]A {
+			return make(map[K]A)
+		},
+		Accumulate: func(a map[K]A, v T) map[K]A {
+			k := key(v)
+			ga, ok := a[k]
+			if !ok {
+				ga = downstream.Supply(0)
+			}
+			a[k] = downstream.Accumulate(ga, v)
+			return a
+		},
+		Finish: func(a map[K]A) map[K]R {
+			r := make(map[K]R, len(a))
+			for k, ga := range a {
+				r[k] = downstream.Finish(ga)
+			}
+			return r
+		},
+	}
+}
+
+// Partition returns a Collector that splits the values in the values for which the predicate returns true and the
+// values for which it returns false, and collects both partitions with the downstream Collector. The result always
+// contains both the true and the false key.
+func Partition[T any, A any, R any](predicate PredicateFunc[T], downstream Collector[T, A, R]) Collector[T, map[bool]A, map[bool]R] {
+	return Collector[T, map[bool]A, map[bool]R]{
+		Supply: func(int) map[bool]A {
+			return map[bool]A{
+				true:  downstream.Supply(0),
+				false: downstream.Supply(0),
+			}
+		},
+		Accumulate: func(a map[bool]A, v T) map[bool]A {
+			k := predicate(v)
+			a[k] = downstream.Accumulate(a[k], v)
+			return a
+		},
+		Finish: func(a map[bool]A) map[bool]R {
+			return map[bool]R{
+				true:  downstream.Finish(a[true]),
+				false: downstream.Finish(a[false]),
+			}
+		},
+	}
+}
+
+// CountBy returns a Collector that counts the values per key returned by the key closure.
+func CountBy[T any, K comparable](key MapFunc[T, K]) Collector[T, map[K]int, map[K]int] {
+	return GroupBy(key, Counting[T]())
+}
@@ -0,0 +1,123 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleGroupBy() {
+	words := FromSlice([]string{"apple", "avocado", "banana", "blueberry", "cherry"})
+
+	// firstLetter is the key closure that groups the words by their first letter.
+	firstLetter := func(s string) string {
+		return s[:1]
+	}
+
+	// Group the words by their first letter, and sum the lengths of the words in each group.
+	lengths, _ := Collect[string](words, GroupBy(firstLetter, Mapping(func(s string) int {
+		return len(s)
+	}, Reducing(0, func(a, b int) int {
+		return a + b
+	}))))
+
+	fmt.Println(lengths)
+
+	// Output:
+	// map[a:12 b:15 c:6]
+}
+
+// Tests
+
+type collectFixture struct {
+	result string
+	err    error
+}
+
+var cl collectFixture
+
+func collected[A any, R any](c Collector[int, A, R]) {
+	var r R
+	r, cl.err = Collect(t.resultingIntIterator, c)
+	cl.result = fmt.Sprint(r)
+}
+
+func oddOrEven(v int) string {
+	if v%2 == 0 {
+		return "even"
+	}
+	return "odd"
+}
+
+func modulo3(v int) int {
+	return v % 3
+}
+
+func sum(a, b int) int {
+	return a + b
+}
+
+func collectIsCalledWithGroupByOddOrEvenAndADownstreamCollectorThatSumsTheValues() {
+	collected(GroupBy(oddOrEven, Reducing(0, sum)))
+}
+
+func collectIsCalledWithGroupByOddOrEvenAndADownstreamGroupByGreaterThanThatAppendsTheValues(n int) {
+	collected(GroupBy(oddOrEven, GroupBy(func(v int) bool {
+		return v > n
+	}, Appending[int]())))
+}
+
+func collectIsCalledWithToMapKeyedByTheValueModuloAndAMergeFunctionThatKeepsTheFirstValue() {
+	collected(ToMap(modulo3, identity[int], func(existing, _ int) int {
+		return existing
+	}))
+}
+
+func collectIsCalledWithToMapKeyedByTheValueModuloAndNoMergeFunction() {
+	collected(ToMap[int, int, int](modulo3, identity[int], nil))
+}
+
+func collectIsCalledWithPartitionOfOddNumbersThatCountsTheValues() {
+	collected(Partition(func(v int) bool {
+		return v%2 != 0
+	}, Counting[int]()))
+}
+
+func collectIsCalledWithCountByTheValueModulo() {
+	collected(CountBy(modulo3))
+}
+
+func collectIsCalledWithToSet() {
+	collected(ToSet[int]())
+}
+
+func theCollectedResultIs(expected string) error {
+	if cl.result != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, cl.result)
+	}
+	return nil
+}
+
+func collectReturnedAnError() error {
+	if cl.err == nil {
+		return errors.New("expected an error but got nil")
+	}
+	return nil
+}
+
+func initializeCollectScenario(ctx *godog.ScenarioContext) {
+	cl = collectFixture{}
+
+	ctx.Step(`^Collect is called with GroupBy odd or even and a downstream Collector that sums the values$`, collectIsCalledWithGroupByOddOrEvenAndADownstreamCollectorThatSumsTheValues)
+	ctx.Step(`^Collect is called with GroupBy odd or even and a downstream GroupBy greater than (\d+) that appends the values$`, collectIsCalledWithGroupByOddOrEvenAndADownstreamGroupByGreaterThanThatAppendsTheValues)
+	ctx.Step(`^Collect is called with ToMap keyed by the value modulo 3 and a merge function that keeps the first value$`, collectIsCalledWithToMapKeyedByTheValueModuloAndAMergeFunctionThatKeepsTheFirstValue)
+	ctx.Step(`^Collect is called with ToMap keyed by the value modulo 3 and no merge function$`, collectIsCalledWithToMapKeyedByTheValueModuloAndNoMergeFunction)
+	ctx.Step(`^Collect is called with Partition of odd numbers that counts the values$`, collectIsCalledWithPartitionOfOddNumbersThatCountsTheValues)
+	ctx.Step(`^Collect is called with CountBy the value modulo 3$`, collectIsCalledWithCountByTheValueModulo)
+	ctx.Step(`^Collect is called with ToSet$`, collectIsCalledWithToSet)
+	ctx.Step(`^the collected result is "([^"]*)"$`, theCollectedResultIs)
+	ctx.Step(`^Collect returned an error$`, collectReturnedAnError)
+}
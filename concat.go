@@ -0,0 +1,160 @@
+package iterator
+
+// Concat
+
+// ConcatIterator is a struct the implements an Iterable that returns the values of several Iterables one after
+// another.
+type ConcatIterator[T any] struct {
+	// iters contains the Iterables this iterator pulls the values from.
+	iters []Iterable[T]
+	// idx contains the position of the Iterable the values are pulled from.
+	idx int
+	// err contains the error of the Iterable that stopped the iteration.
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// Each Iterable is closed when it has no more values. The iteration stops at the first Iterable that has an error,
+// or that cannot be closed, the remaining Iterables are not iterated.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *ConcatIterator[T]) Next() (T, bool) {
+	for iter.err == nil && iter.idx < len(iter.iters) {
+		src := iter.iters[iter.idx]
+		if v, b := src.Next(); b {
+			return v, b
+		}
+		iter.err = Finish(src)
+		iter.idx++
+	}
+	var t T
+	return t, false
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the Iterable that stopped the iteration, joined with the error of closing it, is
+// returned.
+func (iter *ConcatIterator[T]) Error() error {
+	return iter.err
+}
+
+// Close closes the Iterables that implement io.Closer and are not closed yet, including the Iterables that are not
+// iterated yet.
+func (iter *ConcatIterator[T]) Close() error {
+	iters := make([]any, 0, len(iter.iters)-iter.idx)
+	for _, src := range iter.iters[iter.idx:] {
+		iters = append(iters, src)
+	}
+	return closeAll(iters...)
+}
+
+// SizeHint returns the sum of the size hints of the Iterables that are not completely iterated yet.
+func (iter *ConcatIterator[T]) SizeHint() (int, int, bool) {
+	if iter.err != nil {
+		return exactHint(0)
+	}
+	lower, upper := 0, 0
+	for _, src := range iter.iters[iter.idx:] {
+		l, u, _ := SizeHint(src)
+		lower, upper = addHint(lower, l), addHint(upper, u)
+	}
+	return lower, upper, lower == upper
+}
+
+// Concat accepts any number of Iterables and creates a ConcatIterator that returns all values of the first
+// Iterable, then all values of the second Iterable and so on.
+func Concat[T any](iters ...Iterable[T]) *ConcatIterator[T] {
+	return &ConcatIterator[T]{
+		iters: iters,
+	}
+}
+
+// Flatten
+
+// FlattenIterator is a struct the implements an Iterable that returns the values of each Iterable returned by the
+// source Iterable.
+type FlattenIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the inner Iterables from.
+	srcItr Iterable[Iterable[T]]
+	// inner contains the inner Iterable the values are pulled from.
+	inner Iterable[T]
+	// err contains the error of the inner Iterable that stopped the iteration.
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// The next inner Iterable is only pulled from the source Iterable when the current inner Iterable has no more
+// values. Each inner Iterable is closed when it has no more values. The iteration stops at the first inner Iterable
+// that has an error, or that cannot be closed.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *FlattenIterator[T]) Next() (T, bool) {
+	for iter.err == nil {
+		if iter.inner != nil {
+			if v, b := iter.inner.Next(); b {
+				return v, b
+			}
+			inner := iter.inner
+			iter.inner = nil
+			if iter.err = Finish(inner); iter.err != nil {
+				break
+			}
+		}
+		inner, b := iter.srcItr.Next()
+		if !b {
+			break
+		}
+		iter.inner = inner
+	}
+	var t T
+	return t, false
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the inner Iterable that stopped the iteration, joined with the error of closing
+// it, is returned, otherwise the error of the source Iterable.
+func (iter *FlattenIterator[T]) Error() error {
+	if iter.err != nil {
+		return iter.err
+	}
+	return iter.srcItr.Error()
+}
+
+// Close closes the current inner Iterable and the source Iterable when they implement io.Closer.
+func (iter *FlattenIterator[T]) Close() error {
+	return closeAll(iter.inner, iter.srcItr)
+}
+
+// SizeHint returns the size hint of the current inner Iterable, the upper bound is unknown while the source Iterable
+// can return more inner Iterables.
+func (iter *FlattenIterator[T]) SizeHint() (int, int, bool) {
+	if iter.err != nil {
+		return exactHint(0)
+	}
+	lower, upper := 0, 0
+	if iter.inner != nil {
+		lower, upper, _ = SizeHint(iter.inner)
+	}
+	if _, outer, _ := SizeHint(iter.srcItr); outer != 0 {
+		upper = -1
+	}
+	return lower, upper, lower == upper
+}
+
+// Flatten accepts an Iterable of Iterables and creates a FlattenIterator that returns all values of each inner
+// Iterable one after another.
+func Flatten[T any](iter Iterable[Iterable[T]]) *FlattenIterator[T] {
+	return &FlattenIterator[T]{
+		srcItr: iter,
+	}
+}
+
+// FlatMap
+
+// FlatMapFunc is the closure type that needs to be provided to FlatMap to expand a value to an Iterable.
+type FlatMapFunc[T any, R any] func(T) Iterable[R]
+
+// FlatMap accepts an Iterable and FlatMapFunc closure and creates a FlattenIterator that expands each value of the
+// provided Iterable to an Iterable with the closure and returns the values of those Iterables. Each value is only
+// expanded when the values of the previous expansion have been returned.
+func FlatMap[T any, R any](iter Iterable[T], f FlatMapFunc[T, R]) *FlattenIterator[R] {
+	return Flatten[R](Map[T, Iterable[R]](iter, MapFunc[T, Iterable[R]](f)))
+}
@@ -0,0 +1,98 @@
+package iterator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleConcat() {
+	// Concat iterates several pages one after another.
+	ci := Concat[int](FromSlice([]int{1, 2}), FromSlice([]int{3}), Sequence(4, 5))
+
+	_ = ForEach[int](ci, func(v int) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 4
+	// 5
+}
+
+func ExampleFlatMap() {
+	// words expands each line to the words of the line.
+	words := func(line string) Iterable[string] {
+		return FromSlice(strings.Fields(line))
+	}
+
+	fi := FlatMap[string, string](FromSlice([]string{"hello world", "", "goodbye"}), words)
+
+	_ = ForEach[string](fi, func(v string) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// hello
+	// world
+	// goodbye
+}
+
+// Tests
+
+func concatIsCalledWithAnIterableWithTheFollowingValues(table *godog.Table) error {
+	s, err := toSliceOfInts(table)
+	if err != nil {
+		return err
+	}
+	t.resultingIntIterator = Concat(t.resultingIntIterator, Iterable[int](FromSlice(s)))
+	return nil
+}
+
+func concatIsCalledWithAnIterableInAnErrorState() {
+	t.resultingIntIterator = Concat(t.resultingIntIterator, Iterable[int](&ErrorIterator[int]{}))
+}
+
+func flattenIsCalledWithIterablesOfTheFollowingValues(values string) error {
+	var inner []Iterable[int]
+	for _, part := range strings.Split(values, "|") {
+		var s []int
+		if part != "" {
+			var err error
+			if s, err = valuesStringToIntSlice(part); err != nil {
+				return err
+			}
+		}
+		inner = append(inner, FromSlice(s))
+	}
+	t.resultingIntIterator = Flatten(Iterable[Iterable[int]](FromSlice(inner)))
+	return nil
+}
+
+func flatMapIsCalledWithAFunctionThatRepeatsEachValueTheValueTimes() {
+	t.resultingIntIterator = FlatMap(t.resultingIntIterator, func(v int) Iterable[int] {
+		return RepeatingIntegerGenerator(v, uint64(v), 0)
+	})
+}
+
+func flatMapIsCalledWithAFunctionThatReturnsAnIterableInAnErrorStateFor(n int) {
+	t.resultingIntIterator = FlatMap(t.resultingIntIterator, func(v int) Iterable[int] {
+		if v == n {
+			return &ErrorIterator[int]{}
+		}
+		return FromSlice([]int{v})
+	})
+}
+
+func initializeConcatScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^Concat is called with an Iterable with the following values:$`, concatIsCalledWithAnIterableWithTheFollowingValues)
+	ctx.Step(`^Concat is called with an Iterable in an error state$`, concatIsCalledWithAnIterableInAnErrorState)
+	ctx.Step(`^Flatten is called with Iterables of the following values: "([^"]*)"$`, flattenIsCalledWithIterablesOfTheFollowingValues)
+	ctx.Step(`^FlatMap is called with a function that repeats each value the value times$`, flatMapIsCalledWithAFunctionThatRepeatsEachValueTheValueTimes)
+	ctx.Step(`^FlatMap is called with a function that returns an Iterable in an error state for (\d+)$`, flatMapIsCalledWithAFunctionThatReturnsAnIterableInAnErrorStateFor)
+}
@@ -0,0 +1,89 @@
+package iterator
+
+import "context"
+
+// ContextIterator is a struct that implements an Iterable that stops the iteration when a context is done.
+type ContextIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// ctx contains the context that stops the iteration when it is done.
+	ctx context.Context
+	// err contains the error of ctx after the iteration was stopped by it.
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// The context is checked before each value is pulled from the source Iterable. A Next call on the source Iterable
+// that blocks is not interrupted, use a source that is context-aware, like FromChannelContext, for that.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *ContextIterator[T]) Next() (T, bool) {
+	if iter.err == nil {
+		iter.err = iter.ctx.Err()
+	}
+	if iter.err != nil {
+		var t T
+		return t, false
+	}
+	return iter.srcItr.Next()
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the context is returned when the iteration was stopped by the context.
+func (iter *ContextIterator[T]) Error() error {
+	if iter.err != nil {
+		return iter.err
+	}
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *ContextIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the upper bound of the source Iterable, because the context can stop the iteration early.
+func (iter *ContextIterator[T]) SizeHint() (int, int, bool) {
+	return upperHint(iter.srcItr)
+}
+
+// WithContext accepts a context and an Iterable and creates a ContextIterator that returns the values of the
+// provided Iterable until the context is done.
+func WithContext[T any](ctx context.Context, iter Iterable[T]) *ContextIterator[T] {
+	return &ContextIterator[T]{
+		srcItr: iter,
+		ctx:    ctx,
+	}
+}
+
+// ForEachContext is like ForEach, but stops calling the ForEachFunc closure when the provided context is done.
+// The error of the context is returned when the iteration was stopped by the context.
+func ForEachContext[T any](ctx context.Context, iter Iterable[T], f ForEachFunc[T]) error {
+	return ForEach[T](WithContext(ctx, iter), f)
+}
+
+// ReduceContext is like Reduce, but stops reducing when the provided context is done.
+// The value reduced so far and the error of the context are returned when the iteration was stopped by the context.
+func ReduceContext[T any, R any](ctx context.Context, iter Iterable[T], init R, reducer ReduceFunc[T, R]) (R, error) {
+	return Reduce[T](WithContext(ctx, iter), init, reducer)
+}
+
+// ToSliceContext is like ToSlice, but stops when the provided context is done.
+// The values collected so far and the error of the context are returned when the iteration was stopped by the
+// context.
+func ToSliceContext[T any](ctx context.Context, iter Iterable[T]) ([]T, error) {
+	return ToSlice[T](WithContext(ctx, iter))
+}
+
+// ToChannelContext is like ToChannel, but stops when the provided context is done, including while it is waiting
+// for the channel to accept a value. The error of the context is returned when it was stopped by the context.
+func ToChannelContext[T any](ctx context.Context, iter Iterable[T], c chan<- T) error {
+	ci := WithContext(ctx, iter)
+	for v, b := ci.Next(); b; v, b = ci.Next() {
+		select {
+		case c <- v:
+		case <-ctx.Done():
+			return join(ctx.Err(), Close[T](ci))
+		}
+	}
+	return Finish[T](ci)
+}
@@ -0,0 +1,213 @@
+package iterator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromChannelContext() {
+	// The channel is never closed, so FromChannel would block forever.
+	c := make(chan int)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// FromChannelContext stops waiting on the channel when the context is done.
+	ci := FromChannelContext(ctx, c)
+
+	err := ForEach[int](ci, func(v int) {
+		fmt.Println(v)
+	})
+
+	fmt.Println(err)
+
+	// Output:
+	// context deadline exceeded
+}
+
+func ExampleChannelIterator_Context() {
+	c := make(chan int)
+	ci := FromChannelContext(context.Background(), c)
+	stopped := make(chan struct{})
+
+	// The producer stops when the context of the ChannelIterator is done, so it does not leak when the consumer
+	// stops early.
+	go func() {
+		defer close(stopped)
+		for i := 1; ; i++ {
+			select {
+			case c <- i:
+			case <-ci.Context().Done():
+				return
+			}
+		}
+	}()
+
+	// First stops after the first value and closes the ChannelIterator, which cancels its context.
+	v, _, _ := First[int](ci)
+	<-stopped
+
+	fmt.Println(v)
+
+	// Output:
+	// 1
+}
+
+func ExampleChannelIterator_Close() {
+	c := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	// The producer stops when ctx is cancelled.
+	go func() {
+		defer close(stopped)
+		for i := 1; ; i++ {
+			select {
+			case c <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// FromChannel has no context, OnClose cancels the context of the producer when the iterator is closed.
+	ci := OnClose[int](FromChannel(c), func() error {
+		cancel()
+		return nil
+	})
+
+	// Take and ToSlice stop after three values and close the iterator, and with it the producer.
+	values, _ := ToSlice[int](Take[int](ci, 3))
+	<-stopped
+
+	fmt.Println(values)
+
+	// Output:
+	// [1 2 3]
+}
+
+// Tests
+
+type contextFixture struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	err     error
+	stopped chan struct{}
+}
+
+var cx contextFixture
+
+func aContext() {
+	cx.ctx, cx.cancel = context.WithCancel(context.Background())
+}
+
+func aCancelledContext() {
+	aContext()
+	cx.cancel()
+}
+
+func withContextIsCalled() {
+	t.resultingIntIterator = WithContext(cx.ctx, t.resultingIntIterator)
+}
+
+func fromChannelContextIsCalled() {
+	t.resultingIntIterator = FromChannelContext(cx.ctx, t.channel)
+}
+
+func aProducerSendsValuesUntilTheContextOfTheChannelIteratorIsDone() error {
+	ci, ok := t.resultingIntIterator.(*ChannelIterator[int])
+	if !ok {
+		return fmt.Errorf("expected a ChannelIterator got: %T", t.resultingIntIterator)
+	}
+	c, stopped := t.channel, make(chan struct{})
+	cx.stopped = stopped
+	go func() {
+		defer close(stopped)
+		for i := 1; ; i++ {
+			select {
+			case c <- i:
+			case <-ci.Context().Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func firstIsCalledOnTheIntIterator() error {
+	_, _, err := First(t.resultingIntIterator)
+	return err
+}
+
+func theProducerStops() error {
+	select {
+	case <-cx.stopped:
+		return nil
+	case <-time.After(time.Second):
+		return errors.New("expected the producer to stop")
+	}
+}
+
+func errorOfIntIteratorReturnsTheContextError() error {
+	if err := t.resultingIntIterator.Error(); !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("expected: %v got: %v", context.Canceled, err)
+	}
+	return nil
+}
+
+func aForeachFunctionThatCancelsTheContextAfterCalls(n int) {
+	t.counter = func(int) {
+		t.count++
+		if t.count == n {
+			cx.cancel()
+		}
+	}
+}
+
+func forEachContextIsCalled() {
+	cx.err = ForEachContext(cx.ctx, t.resultingIntIterator, t.counter)
+}
+
+func reduceContextIsCalled() {
+	t.sum, cx.err = ReduceContext(cx.ctx, t.resultingIntIterator, t.initialReduceValue, t.reducer)
+}
+
+func toSliceContextIsCalled() {
+	t.resultingSlice, cx.err = ToSliceContext(cx.ctx, t.resultingIntIterator)
+}
+
+func toChannelContextIsCalledWithoutAReceiver() {
+	cx.err = ToChannelContext(cx.ctx, t.resultingIntIterator, t.channel)
+}
+
+func theContextErrorIsReturned() error {
+	if !errors.Is(cx.err, context.Canceled) {
+		return fmt.Errorf("expected: %v got: %v", context.Canceled, cx.err)
+	}
+	return nil
+}
+
+func initializeContextScenario(ctx *godog.ScenarioContext) {
+	cx = contextFixture{}
+
+	ctx.Step(`^a context$`, aContext)
+	ctx.Step(`^a cancelled context$`, aCancelledContext)
+	ctx.Step(`^WithContext is called$`, withContextIsCalled)
+	ctx.Step(`^FromChannelContext is called$`, fromChannelContextIsCalled)
+	ctx.Step(`^a producer sends values until the context of the ChannelIterator is done$`, aProducerSendsValuesUntilTheContextOfTheChannelIteratorIsDone)
+	ctx.Step(`^First is called on the int iterator$`, firstIsCalledOnTheIntIterator)
+	ctx.Step(`^the producer stops$`, theProducerStops)
+	ctx.Step(`^Error\(\) of int iterator returns the context error$`, errorOfIntIteratorReturnsTheContextError)
+	ctx.Step(`^a foreach function that cancels the context after (\d+) calls$`, aForeachFunctionThatCancelsTheContextAfterCalls)
+	ctx.Step(`^ForEachContext is called$`, forEachContextIsCalled)
+	ctx.Step(`^ReduceContext is called$`, reduceContextIsCalled)
+	ctx.Step(`^ToSliceContext is called$`, toSliceContextIsCalled)
+	ctx.Step(`^ToChannelContext is called without a receiver$`, toChannelContextIsCalledWithoutAReceiver)
+	ctx.Step(`^the context error is returned$`, theContextErrorIsReturned)
+}
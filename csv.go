@@ -0,0 +1,395 @@
+package iterator
+
+import (
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// CSV
+
+// CSVOptions contains the options of FromCSV, FromCSVStruct and ToCSV.
+type CSVOptions struct {
+	// Comma is the field delimiter, for example '\t' for TSV. When Comma is 0, ',' is used.
+	Comma rune
+	// Comment is the character that starts a comment line, comment lines are skipped. When Comment is 0, there are
+	// no comment lines.
+	Comment rune
+	// Header tells FromCSV that the first record is a header, which is skipped and returned by the Header method.
+	// FromCSVStruct and ToCSV always use a header.
+	Header bool
+	// TimeLayout is the layout that is used to parse and format time.Time fields. When TimeLayout is empty,
+	// time.RFC3339 is used.
+	TimeLayout string
+}
+
+// timeLayout returns the TimeLayout or time.RFC3339 when it is empty.
+func (o CSVOptions) timeLayout() string {
+	if o.TimeLayout == "" {
+		return time.RFC3339
+	}
+	return o.TimeLayout
+}
+
+// newReader returns a csv.Reader for the io.Reader with the delimiter and comment character of the options.
+func (o CSVOptions) newReader(r io.Reader) *csv.Reader {
+	cr := csv.NewReader(r)
+	if o.Comma != 0 {
+		cr.Comma = o.Comma
+	}
+	cr.Comment = o.Comment
+	return cr
+}
+
+// RowError is the error that is returned by the Error method of a CSVStructIterator when a field cannot be
+// converted to the type of the struct field.
+type RowError struct {
+	// Line is the line of the field, starting at 1.
+	Line int
+	// Column is the column of the field in bytes, starting at 1.
+	Column int
+	// Field is the name of the column in the header.
+	Field string
+	// Err is the conversion error.
+	Err error
+}
+
+// Error returns the error message with the line, column and field name.
+func (e *RowError) Error() string {
+	return fmt.Sprintf("iterator: line %d, column %d, field %q: %v", e.Line, e.Column, e.Field, e.Err)
+}
+
+// Unwrap returns the conversion error.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// CSVIterator is a struct the implements an Iterable that returns the records of a CSV file.
+type CSVIterator struct {
+	// reader contains the csv.Reader the records are read from
+	reader *csv.Reader
+	// skipHeader is true when the first record is a header that is not returned
+	skipHeader bool
+	// header contains the header when skipHeader is true
+	header []string
+	// done is true when the input is completely read or an error occurred
+	done bool
+	// err contains the error that occurred during reading
+	err error
+}
+
+// Next returns the first or next record and true if a record is available.
+// If no more records are available or an error has occurred then nil and false is returned.
+func (iter *CSVIterator) Next() ([]string, bool) {
+	if iter.done {
+		return nil, false
+	}
+	if iter.skipHeader && iter.header == nil {
+		if iter.header = iter.read(); iter.header == nil {
+			return nil, false
+		}
+	}
+	record := iter.read()
+	return record, record != nil
+}
+
+// read returns the next record, or nil when the input is completely read or an error occurred.
+func (iter *CSVIterator) read() []string {
+	record, err := iter.reader.Read()
+	if err != nil {
+		iter.done = true
+		if err != io.EOF {
+			iter.err = err
+		}
+		return nil
+	}
+	return record
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. Parse errors are returned as *csv.ParseError, which contains the line and column.
+func (iter *CSVIterator) Error() error {
+	return iter.err
+}
+
+// Header returns the header when the Header option is set and the first record has been read, otherwise nil.
+func (iter *CSVIterator) Header() []string {
+	return iter.header
+}
+
+// SizeHint returns exactly zero when the iteration is done, otherwise nothing is known about the number of records.
+func (iter *CSVIterator) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromCSV accepts an io.Reader and CSVOptions and returns a CSVIterator that returns the records of the CSV input
+// one by one. Each record is a new slice. The reader is not closed, use OnClose to close it when the iteration is
+// done.
+func FromCSV(r io.Reader, opts CSVOptions) *CSVIterator {
+	return &CSVIterator{reader: opts.newReader(r), skipHeader: opts.Header}
+}
+
+// csvField describes a struct field that is mapped to a CSV column.
+type csvField struct {
+	// name contains the name of the column
+	name string
+	// index contains the index of the struct field
+	index int
+}
+
+// csvFields returns the exported fields of the struct type with their column names. The column name is the name in
+// the csv tag, or the field name when there is no tag. Fields with the tag "-" are skipped.
+func csvFields(typ reflect.Type) ([]csvField, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("iterator: %v is not a struct", typ)
+	}
+	var fields []csvField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := f.Tag.Get("csv")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, csvField{name: name, index: i})
+	}
+	return fields, nil
+}
+
+// CSVStructIterator is a struct the implements an Iterable that returns the records of a CSV file mapped to structs.
+type CSVStructIterator[T any] struct {
+	// reader contains the csv.Reader the records are read from
+	reader *csv.Reader
+	// timeLayout contains the layout of time.Time fields
+	timeLayout string
+	// fields contains the struct field for each column, nil for columns that are not mapped
+	fields []*csvField
+	// done is true when the input is completely read or an error occurred
+	done bool
+	// err contains the error that occurred during reading or converting
+	err error
+}
+
+// Next returns the first or next record mapped to T and true if a record is available.
+// If no more records are available or an error has occurred then a zero value of T and false is returned.
+func (iter *CSVStructIterator[T]) Next() (T, bool) {
+	var t T
+	if iter.done {
+		return t, false
+	}
+	if iter.fields == nil && !iter.readHeader() {
+		return t, false
+	}
+	record, err := iter.reader.Read()
+	if err != nil {
+		return t, iter.fail(err)
+	}
+	v := reflect.ValueOf(&t).Elem()
+	for i, f := range iter.fields {
+		if f == nil || i >= len(record) {
+			continue
+		}
+		if err := parseCSVField(v.Field(f.index), record[i], iter.timeLayout); err != nil {
+			line, column := iter.reader.FieldPos(i)
+			var zero T
+			return zero, iter.fail(&RowError{Line: line, Column: column, Field: f.name, Err: err})
+		}
+	}
+	return t, true
+}
+
+// readHeader reads the header and maps the columns to the struct fields.
+func (iter *CSVStructIterator[T]) readHeader() bool {
+	fields, err := csvFields(reflect.TypeFor[T]())
+	if err != nil {
+		return iter.fail(err)
+	}
+	header, err := iter.reader.Read()
+	if err != nil {
+		return iter.fail(err)
+	}
+	iter.fields = make([]*csvField, len(header))
+	for i, name := range header {
+		for j := range fields {
+			if fields[j].name == name {
+				iter.fields[i] = &fields[j]
+			}
+		}
+	}
+	return true
+}
+
+// fail stops the iteration with the error, unless it is io.EOF, and returns false.
+func (iter *CSVStructIterator[T]) fail(err error) bool {
+	iter.done = true
+	if err != io.EOF {
+		iter.err = err
+	}
+	return false
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. Parse errors are returned as *csv.ParseError and conversion errors as *RowError, which both
+// contain the line and column.
+func (iter *CSVStructIterator[T]) Error() error {
+	return iter.err
+}
+
+// SizeHint returns exactly zero when the iteration is done, otherwise nothing is known about the number of records.
+func (iter *CSVStructIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromCSVStruct accepts an io.Reader and CSVOptions and returns a CSVStructIterator that maps the records of the CSV
+// input to structs of type T. The first record is the header, the columns are mapped to the struct fields by the
+// name in the csv tag, or the field name when there is no tag. Fields with the tag "-", and columns without a
+// field, are ignored. String, integer, floating point, bool and time.Time fields are supported, as well as fields
+// that implement encoding.TextUnmarshaler. Empty values leave the field at its zero value. The reader is not
+// closed, use OnClose to close it when the iteration is done.
+func FromCSVStruct[T any](r io.Reader, opts CSVOptions) *CSVStructIterator[T] {
+	return &CSVStructIterator[T]{reader: opts.newReader(r), timeLayout: opts.timeLayout()}
+}
+
+// parseCSVField converts the CSV value to the type of the struct field and sets it.
+func parseCSVField(v reflect.Value, s string, layout string) error {
+	if s == "" {
+		return nil
+	}
+	if v.Type() == reflect.TypeFor[time.Time]() {
+		tm, err := time.Parse(layout, s)
+		if err == nil {
+			v.Set(reflect.ValueOf(tm))
+		}
+		return err
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+	return nil
+}
+
+// formatCSVField converts the value of a struct field to a CSV value.
+func formatCSVField(v reflect.Value, layout string) (string, error) {
+	if v.Type() == reflect.TypeFor[time.Time]() {
+		return v.Interface().(time.Time).Format(layout), nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	}
+	return "", fmt.Errorf("iterator: unsupported type %v", v.Type())
+}
+
+// csvRecords returns the header and a closure that converts a value of T to a record. When T is []string there is
+// no header and the value is the record.
+func csvRecords[T any](layout string) ([]string, func(T) ([]string, error), error) {
+	typ := reflect.TypeFor[T]()
+	if typ == reflect.TypeFor[[]string]() {
+		return nil, func(v T) ([]string, error) {
+			return any(v).([]string), nil
+		}, nil
+	}
+	fields, err := csvFields(typ)
+	if err != nil {
+		return nil, nil, err
+	}
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	return header, func(v T) ([]string, error) {
+		rv := reflect.ValueOf(v)
+		record := make([]string, len(fields))
+		for i, f := range fields {
+			s, err := formatCSVField(rv.Field(f.index), layout)
+			if err != nil {
+				return nil, err
+			}
+			record[i] = s
+		}
+		return record, nil
+	}, nil
+}
+
+// ToCSV writes the values of the Iterable as CSV to the io.Writer. When T is []string, each value is written as a
+// record. Otherwise T must be a struct, then a header is written with the column names of FromCSVStruct, followed by
+// a record for each value. When a value cannot be converted or written, the iteration stops and the error is
+// returned. The Iterable is closed when the iteration is done.
+func ToCSV[T any](iter Iterable[T], w io.Writer, opts CSVOptions) error {
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+	header, toRecord, err := csvRecords[T](opts.timeLayout())
+	if err == nil && header != nil {
+		err = cw.Write(header)
+	}
+	if err != nil {
+		return join(err, Close(iter))
+	}
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		record, err := toRecord(v)
+		if err == nil {
+			err = cw.Write(record)
+		}
+		if err != nil {
+			return join(err, Close(iter))
+		}
+	}
+	cw.Flush()
+	return join(Finish(iter), cw.Error())
+}
@@ -0,0 +1,209 @@
+package iterator
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromCSVStruct() {
+	type order struct {
+		ID     int       `csv:"id"`
+		Amount float64   `csv:"amount"`
+		Paid   bool      `csv:"paid"`
+		Date   time.Time `csv:"date"`
+	}
+
+	input := strings.NewReader("id,date,amount,paid\n1,2024-03-01,9.95,true\n2,2024-03-02,120,false\n")
+	orders := FromCSVStruct[order](input, CSVOptions{TimeLayout: time.DateOnly})
+
+	_ = ForEach[order](orders, func(o order) {
+		fmt.Println(o.ID, o.Date.Format(time.DateOnly), o.Amount, o.Paid)
+	})
+
+	// Output:
+	// 1 2024-03-01 9.95 true
+	// 2 2024-03-02 120 false
+}
+
+func ExampleToCSV() {
+	type total struct {
+		Name  string `csv:"name"`
+		Count int    `csv:"count"`
+	}
+
+	totals := FromSlice([]total{{Name: "apples", Count: 3}, {Name: "pears, green", Count: 5}})
+
+	err := ToCSV[total](totals, os.Stdout, CSVOptions{})
+	fmt.Println(err)
+
+	// Output:
+	// name,count
+	// apples,3
+	// "pears, green",5
+	// <nil>
+}
+
+// Tests
+
+// person is the struct the CSV records of the tests are mapped to.
+type person struct {
+	Name   string    `csv:"name"`
+	Age    int8      `csv:"age"`
+	Score  float64   `csv:"score"`
+	Active bool      `csv:"active"`
+	Joined time.Time `csv:"joined"`
+	Note   string    `csv:"-"`
+}
+
+func (p person) String() string {
+	return fmt.Sprintf("%v:%v:%v:%v:%v", p.Name, p.Age, p.Score, p.Active, p.Joined.Format(time.DateOnly))
+}
+
+type csvFixture struct {
+	input   string
+	opts    CSVOptions
+	records *CSVIterator
+	people  Iterable[person]
+	err     error
+	output  bytes.Buffer
+}
+
+var cv *csvFixture
+
+func unquote(s string) (string, error) {
+	return strconv.Unquote(`"` + s + `"`)
+}
+
+func aCSVInput(input string) (err error) {
+	cv.input, err = unquote(input)
+	return
+}
+
+func theCSVDelimiterIs(delim string) error {
+	s, err := unquote(delim)
+	cv.opts.Comma = []rune(s)[0]
+	return err
+}
+
+func theCSVCommentCharacterIs(comment string) {
+	cv.opts.Comment = []rune(comment)[0]
+}
+
+func theCSVInputHasAHeader() {
+	cv.opts.Header = true
+}
+
+func theTimeLayoutIs(layout string) {
+	cv.opts.TimeLayout = layout
+}
+
+func fromCSVIsCalled() {
+	cv.records = FromCSV(strings.NewReader(cv.input), cv.opts)
+}
+
+func fromCSVStructIsCalled() {
+	cv.people = FromCSVStruct[person](strings.NewReader(cv.input), cv.opts)
+}
+
+func theRecordsAre(expected string) error {
+	var records [][]string
+	records, cv.err = ToSlice[[]string](cv.records)
+	if got := fmt.Sprintf("%q", records); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theHeaderIs(expected string) error {
+	if got := fmt.Sprintf("%q", cv.records.Header()); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func thePeopleAre(expected string) error {
+	var people []person
+	people, cv.err = ToSlice(cv.people)
+	if got := fmt.Sprint(people); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theCSVErrorIsNil() error {
+	if cv.err != nil {
+		return fmt.Errorf("expected nil got: %v", cv.err)
+	}
+	return nil
+}
+
+func theCSVErrorIsARowErrorAtLineAndColumnForField(line, column int, field string) error {
+	var re *RowError
+	if !errors.As(cv.err, &re) {
+		return fmt.Errorf("expected a RowError got: %v", cv.err)
+	}
+	if re.Line != line || re.Column != column || re.Field != field {
+		return fmt.Errorf("expected: line %v, column %v and field %v got: %v", line, column, field, re)
+	}
+	return nil
+}
+
+func theCSVErrorIsAParseErrorAtLine(line int) error {
+	var pe *csv.ParseError
+	if !errors.As(cv.err, &pe) {
+		return fmt.Errorf("expected a ParseError got: %v", cv.err)
+	}
+	if pe.Line != line {
+		return fmt.Errorf("expected: line %v got: %v", line, pe.Line)
+	}
+	return nil
+}
+
+func theRecordsAreWrittenWithToCSV() error {
+	return ToCSV[[]string](cv.records, &cv.output, cv.opts)
+}
+
+func thePeopleAreWrittenWithToCSV() error {
+	return ToCSV(cv.people, &cv.output, cv.opts)
+}
+
+func theCSVOutputIs(expected string) error {
+	s, err := unquote(expected)
+	if err != nil {
+		return err
+	}
+	if cv.output.String() != s {
+		return fmt.Errorf("expected: %q got: %q", s, cv.output.String())
+	}
+	return nil
+}
+
+func initializeCSVScenario(ctx *godog.ScenarioContext) {
+	cv = &csvFixture{}
+	ctx.Step(`^a CSV input "(.*)"$`, aCSVInput)
+	ctx.Step(`^the CSV delimiter is "(.*)"$`, theCSVDelimiterIs)
+	ctx.Step(`^the CSV comment character is "(.)"$`, theCSVCommentCharacterIs)
+	ctx.Step(`^the CSV input has a header$`, theCSVInputHasAHeader)
+	ctx.Step(`^the time layout is "(.*)"$`, theTimeLayoutIs)
+	ctx.Step(`^FromCSV is called$`, fromCSVIsCalled)
+	ctx.Step(`^FromCSVStruct is called$`, fromCSVStructIsCalled)
+	ctx.Step(`^the records are: (.*)$`, theRecordsAre)
+	ctx.Step(`^the header is: (.*)$`, theHeaderIs)
+	ctx.Step(`^the people are: (.*)$`, thePeopleAre)
+	ctx.Step(`^the CSV error is nil$`, theCSVErrorIsNil)
+	ctx.Step(`^the CSV error is a RowError at line (\d+) and column (\d+) for field "([^"]*)"$`, theCSVErrorIsARowErrorAtLineAndColumnForField)
+	ctx.Step(`^the CSV error is a ParseError at line (\d+)$`, theCSVErrorIsAParseErrorAtLine)
+	ctx.Step(`^the records are written with ToCSV$`, theRecordsAreWrittenWithToCSV)
+	ctx.Step(`^the people are written with ToCSV$`, thePeopleAreWrittenWithToCSV)
+	ctx.Step(`^the CSV output is "(.*)"$`, theCSVOutputIs)
+}
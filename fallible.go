@@ -0,0 +1,171 @@
+package iterator
+
+// Fallible algorithms
+// MapErr
+
+// MapErrFunc is the closure type that needs to be provided to MapErr to perform the mapping operation with.
+// When an error is returned the iteration stops.
+type MapErrFunc[T any, R any] func(T) (R, error)
+
+// MapErrIterator is a struct the implements an Iterable that performs a map operation that can fail.
+type MapErrIterator[T any, R any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// mapFunc is the closure that performs the map operation.
+	mapFunc MapErrFunc[T, R]
+	// err contains the first error returned by mapFunc.
+	err error
+}
+
+// Next returns the first or next value of R and true if a value is available.
+// Each value is transformed with the provided MapErrFunc closure.
+// If no more values are available or an error has occurred then a zero value of R and false is returned.
+func (iter *MapErrIterator[T, R]) Next() (R, bool) {
+	var r R
+	if iter.err != nil {
+		return r, false
+	}
+	v, b := iter.srcItr.Next()
+	if !b {
+		return r, false
+	}
+	if r, iter.err = iter.mapFunc(v); iter.err != nil {
+		var zero R
+		return zero, false
+	}
+	return r, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the MapErrFunc closure is returned when it stopped the iteration.
+func (iter *MapErrIterator[T, R]) Error() error {
+	if iter.err != nil {
+		return iter.err
+	}
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *MapErrIterator[T, R]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the upper bound of the source Iterable, because an error can stop the iteration early.
+func (iter *MapErrIterator[T, R]) SizeHint() (int, int, bool) {
+	return upperHint(iter.srcItr)
+}
+
+// MapErr accepts an Iterable and MapErrFunc closure and creates a MapErrIterator that
+// will perform the map operation on the values of the provided Iterable and
+// returns the transformed values when iterated until the closure returns an error.
+func MapErr[T any, R any](iter Iterable[T], f MapErrFunc[T, R]) *MapErrIterator[T, R] {
+	return &MapErrIterator[T, R]{
+		srcItr:  iter,
+		mapFunc: f,
+	}
+}
+
+// FilterErr
+
+// PredicateErrFunc is the closure type that needs to be provided to FilterErr to perform the filter operation with.
+// If the predicate returns true the value will be returned, otherwise it will be filtered.
+// When an error is returned the iteration stops.
+type PredicateErrFunc[T any] func(T) (bool, error)
+
+// FilterErrIterator is a struct the implements an Iterable that performs a filter operation that can fail.
+type FilterErrIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// predicate is the closure that determines is the value needs to be filtered or not.
+	predicate PredicateErrFunc[T]
+	// err contains the first error returned by predicate.
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// Each value is checked against the provided PredicateErrFunc closure. When false is returned the value will be
+// filtered.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *FilterErrIterator[T]) Next() (T, bool) {
+	var t T
+	if iter.err != nil {
+		return t, false
+	}
+	for v, b := iter.srcItr.Next(); b; v, b = iter.srcItr.Next() {
+		var keep bool
+		if keep, iter.err = iter.predicate(v); iter.err != nil {
+			return t, false
+		}
+		if keep {
+			return v, true
+		}
+	}
+	return t, false
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the PredicateErrFunc closure is returned when it stopped the iteration.
+func (iter *FilterErrIterator[T]) Error() error {
+	if iter.err != nil {
+		return iter.err
+	}
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *FilterErrIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the upper bound of the source Iterable, because values can be filtered.
+func (iter *FilterErrIterator[T]) SizeHint() (int, int, bool) {
+	return upperHint(iter.srcItr)
+}
+
+// FilterErr accepts an Iterable and PredicateErrFunc closure and creates a FilterErrIterator that
+// will perform the filter operation on the values of the provided Iterable and
+// returns the filtered values when iterated until the closure returns an error.
+func FilterErr[T any](iter Iterable[T], predicate PredicateErrFunc[T]) *FilterErrIterator[T] {
+	return &FilterErrIterator[T]{
+		srcItr:    iter,
+		predicate: predicate,
+	}
+}
+
+// ReduceErr
+
+// ReduceErrFunc is the closure type that needs to be provided to ReduceErr to perform the reduce operation with.
+// When an error is returned the reduce operation stops.
+type ReduceErrFunc[T any, R any] func(R, T) (R, error)
+
+// ReduceErr accepts an Iterable, init value and ReduceErrFunc and reduces the values of the iterator to a single
+// value by calling the ReduceErrFunc closure. When the closure returns an error, the value reduced so far and the
+// error are returned. The Iterable is closed when the iteration is done.
+func ReduceErr[T any, R any](iter Iterable[T], init R, reducer ReduceErrFunc[T, R]) (R, error) {
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		r, err := reducer(init, v)
+		if err != nil {
+			return init, join(err, Close(iter))
+		}
+		init = r
+	}
+	return init, Finish(iter)
+}
+
+// ForEachErr
+
+// ForEachErrFunc is the closure type that needs to be provided to ForEachErr.
+// When an error is returned the iteration stops.
+type ForEachErrFunc[T any] func(T) error
+
+// ForEachErr accepts an Iterable and calls the provided ForEachErrFunc closure with each value until the closure
+// returns an error. The error of the closure, or the error that occurred during iteration, is returned.
+// The Iterable is closed when the iteration is done.
+func ForEachErr[T any](iter Iterable[T], f ForEachErrFunc[T]) error {
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		if err := f(v); err != nil {
+			return join(err, Close(iter))
+		}
+	}
+	return Finish(iter)
+}
@@ -0,0 +1,127 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleMapErr() {
+	// MapErr stops at the first value that cannot be parsed, and the error is returned by Error.
+	mi := MapErr[string, int](FromSlice([]string{"1", "2", "three", "4"}), strconv.Atoi)
+
+	err := ForEach[int](mi, func(v int) {
+		fmt.Println(v)
+	})
+
+	fmt.Println(err)
+
+	// Output:
+	// 1
+	// 2
+	// strconv.Atoi: parsing "three": invalid syntax
+}
+
+// Tests
+
+type fallibleFixture struct {
+	mapper    MapErrFunc[int, string]
+	predicate PredicateErrFunc[int]
+	reducer   ReduceErrFunc[int, int]
+	counter   ForEachErrFunc[int]
+	err       error
+}
+
+var fx fallibleFixture
+
+func failure(v int) error {
+	return fmt.Errorf("failed on %d", v)
+}
+
+func aFallibleMapFunctionThatConvertsTheIntToAStringAndFailsOn(n int) {
+	fx.mapper = func(v int) (string, error) {
+		if v == n {
+			return "", failure(v)
+		}
+		return strconv.Itoa(v), nil
+	}
+}
+
+func mapErrIsCalled() {
+	t.resultingStringIterator = MapErr(t.resultingIntIterator, fx.mapper)
+}
+
+func aFalliblePredicateThatOnlySelectsOddNumbersAndFailsOn(n int) {
+	fx.predicate = func(v int) (bool, error) {
+		if v == n {
+			return false, failure(v)
+		}
+		return (v % 2) != 0, nil
+	}
+}
+
+func filterErrIsCalled() {
+	t.resultingIntIterator = FilterErr(t.resultingIntIterator, fx.predicate)
+}
+
+func aFallibleReduceFunctionThatSumsAllValuesAndFailsOn(n int) {
+	fx.reducer = func(a, b int) (int, error) {
+		if b == n {
+			return 0, failure(b)
+		}
+		return a + b, nil
+	}
+}
+
+func reduceErrIsCalled() {
+	t.sum, fx.err = ReduceErr(t.resultingIntIterator, t.initialReduceValue, fx.reducer)
+}
+
+func aFallibleForeachFunctionThatSumsAndCountsTheCallsAndFailsOn(n int) {
+	t.count, t.sum = 0, 0
+	fx.counter = func(v int) error {
+		t.count++
+		if v == n {
+			return failure(v)
+		}
+		t.sum += v
+		return nil
+	}
+}
+
+func forEachErrIsCalled() {
+	fx.err = ForEachErr(t.resultingIntIterator, fx.counter)
+}
+
+func theFallibleOperationReturnedAnError() error {
+	if fx.err == nil {
+		return errors.New("expected an error but got nil")
+	}
+	return nil
+}
+
+func theFallibleOperationReturnedNoError() error {
+	if fx.err != nil {
+		return fmt.Errorf("expected nil but got: %v", fx.err)
+	}
+	return nil
+}
+
+func initializeFallibleScenario(ctx *godog.ScenarioContext) {
+	fx = fallibleFixture{}
+
+	ctx.Step(`^a fallible map function that converts the int to a string and fails on (\d+)$`, aFallibleMapFunctionThatConvertsTheIntToAStringAndFailsOn)
+	ctx.Step(`^MapErr is called$`, mapErrIsCalled)
+	ctx.Step(`^a fallible predicate that only selects odd numbers and fails on (\d+)$`, aFalliblePredicateThatOnlySelectsOddNumbersAndFailsOn)
+	ctx.Step(`^FilterErr is called$`, filterErrIsCalled)
+	ctx.Step(`^a fallible reduce function that sums all values and fails on (\d+)$`, aFallibleReduceFunctionThatSumsAllValuesAndFailsOn)
+	ctx.Step(`^ReduceErr is called$`, reduceErrIsCalled)
+	ctx.Step(`^a fallible foreach function that sums and counts the calls and fails on (\d+)$`, aFallibleForeachFunctionThatSumsAndCountsTheCallsAndFailsOn)
+	ctx.Step(`^ForEachErr is called$`, forEachErrIsCalled)
+	ctx.Step(`^the fallible operation returned an error$`, theFallibleOperationReturnedAnError)
+	ctx.Step(`^the fallible operation returned no error$`, theFallibleOperationReturnedNoError)
+}
@@ -0,0 +1,115 @@
+Feature: Archive entries are streamed from and to tar and zip archives
+
+  Scenario: FromTar returns the entries of a tar archive
+    Given a tar archive with the files:
+      | a.txt     | hello |
+      | b/c.txt   | world |
+      | empty.txt |       |
+    When FromTar is called
+    Then the archive entries are: "a.txt=hello,b/c.txt=world,empty.txt="
+    And Error() of the archive returns nil
+
+  Scenario: FromTar decompresses a gzip compressed tar archive transparently
+    Given a tar archive with the files:
+      | a.txt | hello |
+      | b.txt | world |
+    And the tar archive is compressed with gzip
+    When FromTar is called
+    Then the archive entries are: "a.txt=hello,b.txt=world"
+    And Error() of the archive returns nil
+
+  Scenario: FromTar returns no entries for an empty archive
+    Given a tar archive with the files:
+      | a.txt | hello |
+    And the archive is truncated to 0 bytes
+    When FromTar is called
+    Then the archive entries are: ""
+    And Error() of the archive returns nil
+
+  Scenario: A truncated tar archive shows up in Error()
+    Given a tar archive with the files:
+      | a.txt | hello |
+      | b.txt | world |
+    And the archive is truncated to 1124 bytes
+    When FromTar is called
+    Then the archive entries are: "a.txt=hello"
+    And Error() of the archive returns io.ErrUnexpectedEOF
+
+  Scenario: A truncated entry of a tar archive shows up in Error()
+    Given a tar archive with the files:
+      | a.txt | hello |
+    And the archive is truncated to 514 bytes
+    When FromTar is called
+    Then the archive entries are: "a.txt=he"
+    And Error() of the archive returns io.ErrUnexpectedEOF
+
+  Scenario: A truncated gzip compressed tar archive shows up in Error()
+    Given a tar archive with the files:
+      | a.txt | hello |
+    And the tar archive is compressed with gzip
+    And the archive is truncated to 20 bytes
+    When FromTar is called
+    Then the archive entries are: ""
+    And Error() of the archive returns io.ErrUnexpectedEOF
+
+  Scenario: The reader of a tar entry is invalidated when Next advances
+    Given a tar archive with the files:
+      | a.txt | hello |
+      | b.txt | world |
+    When the reader of the first tar entry is read after Next
+    Then reading the entry returns ErrEntryInvalidated
+
+  Scenario: ToTar writes the entries to a tar archive
+    Given a tar archive with the files:
+      | a.txt | hello |
+      | b.md  | skip  |
+      | c.txt | world |
+    When the entries ending with ".txt" are written with ToTar
+    And the written archive is read back
+    Then the archive entries are: "a.txt=hello,c.txt=world"
+
+  Scenario: ToTar returns the error of an entry that cannot be written
+    When an entry with the wrong size is written with ToTar
+    Then ToTar returns tar.ErrWriteTooLong
+
+  Scenario: FromZip returns the entries of a zip archive
+    Given a zip archive with the files:
+      | a.txt   | hello |
+      | b/c.txt | world |
+    When FromZip is called
+    Then SizeHint of the zip archive iterator returns 2, 2 and true
+    And the archive entries are: "a.txt=hello,b/c.txt=world"
+    And SizeHint of the zip archive iterator returns 0, 0 and true
+    And Error() of the archive returns nil
+
+  Scenario: A corrupt entry of a zip archive shows up in Error()
+    Given a zip archive with the files:
+      | a.txt | hello |
+      | b.txt | world |
+    And the contents "hello" of the zip entry are corrupted
+    When FromZip is called
+    Then the archive entry names are: "a.txt"
+    And Error() of the archive returns zip.ErrChecksum
+
+  Scenario: A corrupt local header of a zip archive shows up in Error()
+    Given a zip archive with the files:
+      | a.txt | hello |
+    And the local header of the zip archive is corrupted
+    When FromZip is called
+    Then the archive entries are: ""
+    And Error() of the archive returns zip.ErrFormat
+
+  Scenario: The reader of a zip entry is invalidated when the iterator is closed
+    Given a zip archive with the files:
+      | a.txt | hello |
+    When the reader of the first zip entry is read after Close
+    Then reading the entry returns ErrEntryInvalidated
+
+  Scenario: ToZip writes the entries to a zip archive
+    Given a zip archive with the files:
+      | a.txt | hello |
+      | b.md  | skip  |
+      | c.txt | world |
+    When the entries ending with ".txt" are written with ToZip
+    And the written archive is read back
+    Then the archive entries are: "a.txt=hello,c.txt=world"
@@ -0,0 +1,88 @@
+Feature: Chunk, Batch and Window group the values of an iteration in slices
+
+  Scenario Outline: Chunk groups the values in chunks of n values
+    Given a start value of 1
+    And an end value of 7
+    When Sequence is called
+    And Chunk is called with <n>
+    Then the returned slices are: "<results>"
+
+    Examples:
+      | n | results               |
+      | 0 | 1\|2\|3\|4\|5\|6\|7   |
+      | 3 | 1,2,3\|4,5,6\|7       |
+      | 7 | 1,2,3,4,5,6,7         |
+      | 9 | 1,2,3,4,5,6,7         |
+
+  Scenario: Chunk handles errors in source iterator
+    Given an Iterable in an error state
+    When Chunk is called with 2
+    Then the returned slices are: ""
+    And Error() of the slice iterator returns an error
+
+  Scenario: Batch flushes a batch when the size is reached
+    Given a closed channel with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+      | 5 |
+    When Batch is called with size 2 and a duration of 1 hour
+    Then the returned slices are: "1,2|3,4|5"
+    And Error() of the slice iterator returns nil
+
+  Scenario: Batch flushes a batch when the duration has elapsed
+    Given a channel that receives the value 1 and stays open
+    When Batch is called with size 2 and a duration of 10 milliseconds
+    Then the next slice is: "1"
+
+  Scenario: BatchContext returns the partial batch when the context is cancelled
+    Given a channel that receives the value 1 and stays open
+    And a context
+    When BatchContext is called with size 2 and a duration of 1 hour
+    And the context is cancelled after 10 milliseconds
+    Then the returned slices are: "1"
+    And Error() of the slice iterator returns the context error
+
+  Scenario Outline: Window returns sliding windows
+    Given a start value of 1
+    And an end value of 7
+    When Sequence is called
+    And Window is called with size <size> and step <step>
+    Then the returned slices are: "<results>"
+
+    Examples:
+      | size | step | results                             |
+      | 3    | 1    | 1,2,3\|2,3,4\|3,4,5\|4,5,6\|5,6,7   |
+      | 3    | 2    | 1,2,3\|3,4,5\|5,6,7                 |
+      | 2    | 3    | 1,2\|4,5                            |
+      | 3    | 3    | 1,2,3\|4,5,6                        |
+      | 8    | 1    |                                     |
+
+  Scenario Outline: WindowShared returns sliding windows in a shared buffer
+    Given a start value of 1
+    And an end value of 7
+    When Sequence is called
+    And WindowShared is called with size <size> and step <step>
+    Then the returned slices are: "<results>"
+    And all returned slices share the same buffer
+
+    Examples:
+      | size | step | results                             |
+      | 3    | 1    | 1,2,3\|2,3,4\|3,4,5\|4,5,6\|5,6,7   |
+      | 2    | 3    | 1,2\|4,5                            |
+
+  Scenario: Window composes with Map and handles errors in source iterator
+    Given a start value of 1
+    And an end value of 4
+    When Sequence is called
+    And Window is called with size 2 and step 1
+    And the windows are summed with Map
+    Then calling Next() until false is returned should return the following integers:
+      | 3 |
+      | 5 |
+      | 7 |
+
+    Given an Iterable in an error state
+    When Window is called with size 2 and step 1
+    Then Error() of the slice iterator returns an error
@@ -0,0 +1,94 @@
+Feature: Close releases the resources of an iteration and propagates through pipelines
+
+  Scenario Outline: Terminal operations close the Iterable when they are done
+    Given a closable Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When <operation> is called on the closable Iterable
+    Then the closable Iterable is closed
+
+    Examples:
+      | operation |
+      | ForEach   |
+      | Reduce    |
+      | ToSlice   |
+      | ToChannel |
+      | Collect   |
+      | Sum       |
+
+  Scenario: Short-circuiting operations close the Iterable when the answer is known
+    Given a closable Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When Find is called on the closable Iterable
+    Then the closable Iterable is closed
+    And the closable Iterable returned 2 values
+
+  Scenario: Combinators forward Close to their source
+    Given a closable Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When a pipeline of Filter, Map, Take, Chunk and Peekable is created on the closable Iterable
+    And the pipeline is closed
+    Then the closable Iterable is closed
+
+  Scenario: Zip and Concat close all their sources
+    Given a closable Iterable with the following values:
+      | 1 |
+    And another closable Iterable with the following values:
+      | 2 |
+    When Concat is called with both closable Iterables and closed
+    Then both closable Iterables are closed
+
+    Given a closable Iterable with the following values:
+      | 1 |
+    And another closable Iterable with the following values:
+      | 2 |
+    When Zip is called with both closable Iterables and closed
+    Then both closable Iterables are closed
+
+  Scenario: Flatten closes each inner Iterable exactly once
+    When Flatten is called with 3 closable Iterables and ToSlice is called
+    Then the inner Iterables are closed "1,1,1" times
+
+    When Flatten is called with 3 closable Iterables and First is called
+    Then the inner Iterables are closed "1,0,0" times
+
+  Scenario: Concat closes each Iterable exactly once
+    When Concat is called with 3 closable Iterables and ToSlice is called
+    Then the inner Iterables are closed "1,1,1" times
+
+    When Concat is called with 3 closable Iterables and First is called
+    Then the inner Iterables are closed "1,1,1" times
+
+  Scenario: Concat closes an Iterable before the iteration moves on to the next Iterable
+    When Concat is called with 3 closable Iterables and Next() is called 3 times
+    Then the inner Iterables are closed "1,0,0" times
+
+    When Concat is called with 3 closable Iterables and Next() is called 5 times
+    Then the inner Iterables are closed "1,1,0" times
+
+  Scenario: OnClose calls the close function only once
+    Given a closable Iterable with the following values:
+      | 1 |
+    When OnClose is called with a close function that counts the calls
+    And the pipeline is closed
+    And the pipeline is closed
+    Then the close function is called 1 times
+
+  Scenario: Finish joins the error of the iteration with the error of closing
+    Given a closable Iterable in an error state that fails to close
+    When Finish is called on the closable Iterable
+    Then the returned error contains the iteration error and the close error
+
+  Scenario: A closed ChannelIterator stops the iteration
+    Given a closed channel with the following values:
+      | 1 |
+      | 2 |
+    When FromChannel is called
+    And Next() is called once
+    And the ChannelIterator is closed
+    Then Next() returns true 0 times and then returns false
@@ -0,0 +1,46 @@
+Feature: Collect collects the values of an Iterable with a Collector
+
+  Background:
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+      | 5 |
+      | 3 |
+
+  Scenario: GroupBy groups the values and collects each group with a downstream Collector
+    When Collect is called with GroupBy odd or even and a downstream Collector that sums the values
+    Then the collected result is "map[even:6 odd:12]"
+
+  Scenario: GroupBy collectors can be nested
+    When Collect is called with GroupBy odd or even and a downstream GroupBy greater than 2 that appends the values
+    Then the collected result is "map[even:map[false:[2] true:[4]] odd:map[false:[1] true:[3 5 3]]]"
+
+  Scenario: ToMap merges values with the same key with the merge function
+    When Collect is called with ToMap keyed by the value modulo 3 and a merge function that keeps the first value
+    Then the collected result is "map[0:3 1:1 2:2]"
+
+    Given an Iterable with the following values:
+      | 1 |
+      | 4 |
+    When Collect is called with ToMap keyed by the value modulo 3 and no merge function
+    Then the collected result is "map[1:4]"
+
+  Scenario: Partition splits the values with a predicate
+    When Collect is called with Partition of odd numbers that counts the values
+    Then the collected result is "map[false:2 true:4]"
+
+  Scenario: CountBy counts the values per key
+    When Collect is called with CountBy the value modulo 3
+    Then the collected result is "map[0:2 1:2 2:2]"
+
+  Scenario: ToSet collects the distinct values
+    When Collect is called with ToSet
+    Then the collected result is "map[1:{} 2:{} 3:{} 4:{} 5:{}]"
+
+  Scenario: Collect handles errors in source iterator
+    Given an Iterable in an error state
+    When Collect is called with CountBy the value modulo 3
+    Then the collected result is "map[]"
+    And Collect returned an error
@@ -0,0 +1,56 @@
+Feature: Concat, Flatten and FlatMap return the values of several Iterables one after another
+
+  Scenario: Concat returns the values of all Iterables in order
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Concat is called with an Iterable with the following values:
+      | 3 |
+      | 4 |
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+    And Error() of int iterator returns nil
+
+  Scenario: Concat stops at the first Iterable in an error state
+    Given an Iterable in an error state
+    When Concat is called with an Iterable with the following values:
+      | 3 |
+    Then Next() returns true 0 times and then returns false
+    And Error() of int iterator returns an error
+
+    Given an Iterable with the following values:
+      | 1 |
+    When Concat is called with an Iterable in an error state
+    Then Next() returns true 1 times and then returns false
+    And Error() of int iterator returns an error
+
+  Scenario: Flatten returns the values of all inner Iterables in order
+    When Flatten is called with Iterables of the following values: "1,2||3|4,5"
+    Then calling Next() until false is returned should return the following values: "1,2,3,4,5"
+
+  Scenario: FlatMap expands each value to an Iterable
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When FlatMap is called with a function that repeats each value the value times
+    Then calling Next() until false is returned should return the following values: "1,2,2,3,3,3"
+
+  Scenario: FlatMap stops at the first inner Iterable in an error state
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When FlatMap is called with a function that returns an Iterable in an error state for 2
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+    And Error() of int iterator returns an error
+
+  Scenario: FlatMap handles errors in source iterator
+    Given an Iterable in an error state
+    When FlatMap is called with a function that repeats each value the value times
+    Then Next() returns true 0 times and then returns false
+    And Error() of int iterator returns an error
@@ -0,0 +1,107 @@
+Feature: Context stops iterators and terminal operations when it is done
+
+  Scenario: WithContext returns the values of the Iterable while the context is not done
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a context
+    When WithContext is called
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 2 |
+      | 3 |
+    And Error() of int iterator returns nil
+
+  Scenario: WithContext stops the iteration when the context is cancelled
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a cancelled context
+    When WithContext is called
+    Then Next() returns true 0 times and then returns false
+    And Error() of int iterator returns the context error
+
+  Scenario: FromChannelContext stops waiting on the channel when the context is cancelled
+    Given a channel
+    And a cancelled context
+    When FromChannelContext is called
+    Then Next() returns true 0 times and then returns false
+    And Error() of int iterator returns the context error
+
+  Scenario: Closing a ChannelIterator created by FromChannelContext stops the producer
+    Given a channel
+    And a context
+    When FromChannelContext is called
+    And a producer sends values until the context of the ChannelIterator is done
+    And First is called on the int iterator
+    Then the producer stops
+    And Error() of int iterator returns nil
+
+  Scenario: FromChannelContext returns the values of the channel while the context is not done
+    Given a closed channel with the following values:
+      | 1 |
+      | 2 |
+    And a context
+    When FromChannelContext is called
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 2 |
+    And Error() of int iterator returns nil
+
+  Scenario: FromChannelContext does not report an error when Next is called after the end
+    Given a closed channel with the following values:
+      | 1 |
+    And a context
+    When FromChannelContext is called
+    And Next() of the int iterator is called 3 times
+    Then Error() of int iterator returns nil
+
+  Scenario: Chunk over FromChannelContext does not report an error
+    Given a closed channel with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a context
+    When FromChannelContext is called
+    And Chunk is called with 2
+    Then the returned slices are: "1,2|3"
+    And Error() of the slice iterator returns nil
+
+  Scenario: ForEachContext stops calling the function when the context is cancelled
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a context
+    And a foreach function that cancels the context after 2 calls
+    When ForEachContext is called
+    Then The returned count is 2
+    And the context error is returned
+
+  Scenario: ReduceContext returns the context error when the context is cancelled
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    And a reduce function that sums all values
+    And a cancelled context
+    When ReduceContext is called
+    Then the context error is returned
+
+  Scenario: ToSliceContext returns the context error when the context is cancelled
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    And a cancelled context
+    When ToSliceContext is called
+    Then the context error is returned
+
+  Scenario: ToChannelContext stops waiting on the channel when the context is cancelled
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    And a channel
+    And a cancelled context
+    When ToChannelContext is called without a receiver
+    Then the context error is returned
@@ -0,0 +1,59 @@
+Feature: CSV records are read and written one record at a time
+
+  Scenario: FromCSV returns the records
+    Given a CSV input "a,b\n\"c, d\",e\n"
+    When FromCSV is called
+    Then the records are: [["a" "b"] ["c, d" "e"]]
+    And the CSV error is nil
+
+  Scenario: FromCSV skips the header, comment lines and uses a custom delimiter
+    Given a CSV input "name\tage\n# a comment\nalice\t31\nbob\t17\n"
+    And the CSV delimiter is "\t"
+    And the CSV comment character is "#"
+    And the CSV input has a header
+    When FromCSV is called
+    Then the records are: [["alice" "31"] ["bob" "17"]]
+    And the header is: ["name" "age"]
+
+  Scenario: FromCSV reports parse errors with the line
+    Given a CSV input "a,b\nc,\"d\ne,f\n"
+    When FromCSV is called
+    Then the records are: [["a" "b"]]
+    And the CSV error is a ParseError at line 3
+
+  Scenario: FromCSVStruct maps the columns to the struct fields by the header
+    Given a CSV input "joined,name,age,score,active,note\n2024-01-02T00:00:00Z,alice,31,9.5,true,x\n,bob,,,,\n"
+    When FromCSVStruct is called
+    Then the people are: [alice:31:9.5:true:2024-01-02 bob:0:0:false:0001-01-01]
+    And the CSV error is nil
+
+  Scenario: FromCSVStruct uses the time layout
+    Given a CSV input "name,joined\nalice,02/01/2024\n"
+    And the time layout is "02/01/2006"
+    When FromCSVStruct is called
+    Then the people are: [alice:0:0:false:2024-01-02]
+
+  Scenario: FromCSVStruct reports conversion errors with the line, column and field
+    Given a CSV input "name,age\nalice,31\nbob,300\n"
+    When FromCSVStruct is called
+    Then the people are: [alice:31:0:false:0001-01-01]
+    And the CSV error is a RowError at line 3 and column 5 for field "age"
+
+  Scenario: FromCSVStruct returns no values for an empty input
+    Given a CSV input ""
+    When FromCSVStruct is called
+    Then the people are: []
+    And the CSV error is nil
+
+  Scenario: ToCSV writes the header from the struct tags
+    Given a CSV input "name,age,active,joined\nalice,31,true,2024-01-02T00:00:00Z\n"
+    When FromCSVStruct is called
+    And the people are written with ToCSV
+    Then the CSV output is "name,age,score,active,joined\nalice,31,0,true,2024-01-02T00:00:00Z\n"
+
+  Scenario: ToCSV writes records with a custom delimiter
+    Given a CSV input "a;b c\nd;\"e;f\"\n"
+    And the CSV delimiter is ";"
+    When FromCSV is called
+    And the records are written with ToCSV
+    Then the CSV output is "a;b c\nd;\"e;f\"\n"
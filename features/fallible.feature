@@ -0,0 +1,77 @@
+Feature: Fallible operations stop the iteration at the first error returned by the closure
+
+  Scenario: MapErr returns the mapped values until the map function fails
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a fallible map function that converts the int to a string and fails on 3
+    When MapErr is called
+    Then calling Next() until false is returned should return the following strings:
+      | 1 |
+      | 2 |
+    And Error() of string iterator returns an error
+
+  Scenario: MapErr handles errors in source iterator
+    Given an Iterable in an error state
+    And a fallible map function that converts the int to a string and fails on 3
+    When MapErr is called
+    Then Error() of string iterator returns an error
+
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    And a fallible map function that converts the int to a string and fails on 3
+    When MapErr is called
+    Then calling Next() until false is returned should return the following strings:
+      | 1 |
+      | 2 |
+    And Error() of string iterator returns nil
+
+  Scenario: FilterErr returns the filtered values until the predicate fails
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+      | 5 |
+    And a fallible predicate that only selects odd numbers and fails on 4
+    When FilterErr is called
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 3 |
+    And Error() of int iterator returns an error
+
+  Scenario: FilterErr handles errors in source iterator
+    Given an Iterable in an error state
+    And a fallible predicate that only selects odd numbers and fails on 4
+    When FilterErr is called
+    Then Error() of int iterator returns an error
+
+  Scenario: ReduceErr stops reducing when the reduce function fails
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a fallible reduce function that sums all values and fails on 3
+    When ReduceErr is called
+    Then The returned sum is 3
+    And the fallible operation returned an error
+
+  Scenario: ForEachErr stops calling the function when it fails
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a fallible foreach function that sums and counts the calls and fails on 2
+    When ForEachErr is called
+    Then The returned count is 2
+    And the fallible operation returned an error
+
+    Given an Iterable with the following values:
+      | 1 |
+      | 3 |
+    And a fallible foreach function that sums and counts the calls and fails on 2
+    When ForEachErr is called
+    Then The returned sum is 4
+    And the fallible operation returned no error
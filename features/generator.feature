@@ -45,3 +45,89 @@ Feature: Generators return a value generating iterator
       | 2     | 0   | -1   | 2,1,0          |
       | 0     | 3   | 1    | 0,1,2,3        |
       | 0     | 3   | -1   | 0,1,2,3        |
+
+  Scenario Outline: UnsignedStepSequence generates correct sequence of values
+    Given a start value of <start>
+    And an end value of <end>
+    And an step value of <step>
+    When UnsignedStepSequence is called
+    Then calling Next() until false is returned should return the following values: "<results>"
+
+    Examples:
+      | start | end | step | results |
+      | 0     | 8   | 3    | 0,3,6   |
+      | 8     | 0   | 3    | 8,5,2   |
+      | 2     | 10  | 4    | 2,6,10  |
+      | 10    | 2   | 4    | 10,6,2  |
+      | 5     | 5   | 2    | 5       |
+
+  Scenario Outline: UnsignedSequence generates correct sequence of values
+    Given a start value of <start>
+    And an end value of <end>
+    When UnsignedSequence is called
+    Then calling Next() until false is returned should return the following values: "<results>"
+
+    Examples:
+      | start | end | results |
+      | 0     | 3   | 0,1,2,3 |
+      | 3     | 0   | 3,2,1,0 |
+
+  Scenario Outline: Linspace generates evenly spaced values including both endpoints
+    When Linspace is called with <start>, <end> and <n>
+    Then the following floats are returned: "<results>"
+
+    Examples:
+      | start | end | n | results             |
+      | 0     | 1   | 5 | [0 0.25 0.5 0.75 1] |
+      | 1     | 0   | 3 | [1 0.5 0]           |
+      | -1    | 2   | 4 | [-1 0 1 2]          |
+      | 2     | 3   | 1 | [2]                 |
+      | 2     | 3   | 0 | []                  |
+
+  Scenario Outline: Arange generates values up to but excluding the end
+    When Arange is called with <start>, <end> and <step>
+    Then the following floats are returned: "<results>"
+
+    Examples:
+      | start | end | step  | results                        |
+      | 0     | 1   | 0.25  | [0 0.25 0.5 0.75]              |
+      | 0     | 1   | 0.3   | [0 0.3 0.6 0.8999999999999999] |
+      | 1     | 0   | 0.25  | [1 0.75 0.5 0.25]              |
+      | 1     | 0   | -0.25 | [1 0.75 0.5 0.25]              |
+      | 1     | 1.3 | 0.1   | [1 1.1 1.2]                    |
+      | 0     | 1   | 0     | []                             |
+      | 1     | 1   | 0.1   | []                             |
+
+  Scenario Outline: CheckedStepSequence generates exact values at the limits of each integer type
+    Given a start value of <start>
+    And an end value of <end>
+    And an step value of <step>
+    When CheckedStepSequence is called for <type>
+    Then calling Next() until false is returned should return the following values: "<results>"
+
+    Examples:
+      | type  | start                | end                  | step                 | results                                     |
+      | int8  | -128                 | 127                  | 100                  | -128,-28,72                                 |
+      | int8  | 127                  | -128                 | -100                 | 127,27,-73                                  |
+      | int8  | 127                  | -128                 | -128                 | 127,-1                                      |
+      | int32 | -2147483648          | 2147483647           | 2147483647           | -2147483648,-1,2147483646                   |
+      | int32 | 2147483640           | 2147483647           | 3                    | 2147483640,2147483643,2147483646            |
+      | int64 | -9223372036854775808 | 9223372036854775807  | 9223372036854775807  | -9223372036854775808,-1,9223372036854775806 |
+      | int64 | 9223372036854775807  | -9223372036854775808 | -9223372036854775808 | 9223372036854775807,-1                      |
+
+  Scenario: CheckedStepSequence returns an error for a zero step
+    Given a start value of 1
+    And an end value of 10
+    And an step value of 0
+    When CheckedStepSequence is called for int32
+    Then the sequence returns the error ErrZeroStep
+    And StepSequence with a zero step panics with ErrZeroStep
+
+  Scenario: Checked sequences return an error when the number of values does not fit in an uint64
+    Given a start value of -9223372036854775808
+    And an end value of 9223372036854775807
+    When CheckedSequence is called
+    Then the sequence returns the error ErrSequenceOverflow
+
+    When CheckedUnsignedSequence is called with 0 and 18446744073709551615
+    Then the sequence returns the error ErrSequenceOverflow
@@ -0,0 +1,47 @@
+Feature: Repeat, Cycle, Iterate and Unfold generate unbounded and self-terminating sequences
+
+  Scenario: Repeat returns the same value forever
+    When Repeat is called with 7
+    And Take is called with 3
+    Then calling Next() until false is returned should return the following values: "7,7,7"
+    And Error() of int iterator returns nil
+
+  Scenario: Cycle replays the values of the source Iterable
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When Cycle is called
+    And Take is called with 7
+    Then calling Next() until false is returned should return the following values: "1,2,3,1,2,3,1"
+
+  Scenario: Cycle of an empty Iterable returns no values
+    Given an empty Iterable
+    When Cycle is called
+    Then Next() returns true 0 times and then returns false
+    And SizeHint of the int iterator returns 0, 0 and true
+
+  Scenario: Cycle does not replay the values when the source Iterable fails
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Concat is called with an Iterable in an error state
+    And Cycle is called
+    Then Next() returns true 2 times and then returns false
+    And Error() of int iterator returns an error
+
+  Scenario: Iterate applies the function to the previous value
+    When Iterate is called with seed 1 and a function that doubles the value
+    And Take is called with 5
+    Then calling Next() until false is returned should return the following values: "1,2,4,8,16"
+
+  Scenario: Unfold stops when the function returns false
+    When Unfold is called with a function that counts down from 3
+    Then calling Next() until false is returned should return the following values: "3,2,1"
+    And Next() returns true 0 times and then returns false
+
+  Scenario: Take limits the size hint of an infinite generator
+    When Repeat is called with 1
+    Then SizeHint of the int iterator returns 9223372036854775807, -1 and false
+    When Take is called with 3
+    Then SizeHint of the int iterator returns 3, 3 and true
@@ -0,0 +1,89 @@
+Feature: JSON arrays and NDJSON are decoded and encoded one value at a time
+
+  Scenario: FromJSONArray decodes the elements of an array
+    Given a JSON input " [1, 2,\n 3] "
+    When FromJSONArray is called
+    Then calling Next() until false is returned should return the following values: "1,2,3"
+    And Error() of int iterator returns nil
+
+  Scenario: FromJSONArray returns no values for an empty array
+    Given a JSON input "[]"
+    When FromJSONArray is called
+    Then Next() returns true 0 times and then returns false
+    And Error() of int iterator returns nil
+
+  Scenario: FromJSONArray reports the index and offset of an element that cannot be decoded
+    Given a JSON input "[1, 2, \"three\", 4]"
+    When FromJSONArray is called
+    Then Next() returns true 2 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 2 and offset 7
+
+  Scenario: FromJSONArray reports the offset of an element that is not valid JSON
+    Given a JSON input "[1,\n  tru]"
+    When FromJSONArray is called
+    Then Next() returns true 1 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 1 and offset 6
+
+  Scenario: FromJSONArray reports the offset of an element after many reads of the input
+    Given a JSON array of 10000 numbers followed by a string
+    When FromJSONArray is called
+    Then Next() returns true 10000 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 10000 and the offset of the string
+
+  Scenario: FromJSONArray reports truncated input
+    Given a JSON input "[1, 2"
+    When FromJSONArray is called
+    Then Next() returns true 2 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 2 and offset 5
+
+  Scenario: FromJSONArray reports input that is not an array
+    Given a JSON input "{\"a\": 1}"
+    When FromJSONArray is called
+    Then Next() returns true 0 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 0 and offset 0
+
+  Scenario: FromNDJSON decodes one value per line
+    Given a JSON input "1\n2\n\n3\n"
+    When FromNDJSON is called
+    Then calling Next() until false is returned should return the following values: "1,2,3"
+    And Error() of int iterator returns nil
+
+  Scenario: FromNDJSON reports the index and offset of a value that cannot be decoded
+    Given a JSON input "1\n2\n\"three\"\n4\n"
+    When FromNDJSON is called
+    Then Next() returns true 2 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 2 and offset 4
+
+  Scenario: FromNDJSON reports the offset of a value after many reads of the input
+    Given NDJSON of 10000 numbers followed by a string
+    When FromNDJSON is called
+    Then Next() returns true 10000 times and then returns false
+    And Error() of the int iterator returns a DecodeError at index 10000 and the offset of the string
+
+  Scenario: ToJSONArray writes the values as an array
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When ToJSONArray is called
+    Then the output is "[1,2,3]"
+
+  Scenario: ToJSONArray writes an empty array for an empty Iterable
+    Given an empty Iterable
+    When ToJSONArray is called
+    Then the output is "[]"
+
+  Scenario: ToNDJSON writes one value per line
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When ToNDJSON is called
+    Then the output is "1\n2\n"
+
+  Scenario: Values written with ToJSONArray are read back with FromJSONArray
+    Given a start value of 1
+    And an end value of 5
+    When Sequence is called
+    And ToJSONArray is called
+    And the output is read with FromJSONArray
+    Then calling Next() until false is returned should return the following values: "1,2,3,4,5"
@@ -0,0 +1,48 @@
+Feature: FromPages returns the items of a paginated backend
+
+  Scenario: FromPages fetches the pages lazily
+    Given a paginated server with 3 values per page from 1 to 7
+    When FromPages is called
+    Then the server received 0 requests
+    When Next() of the int iterator is called 2 times
+    Then the server received 1 requests
+    And calling Next() until false is returned should return the following values: "3,4,5,6,7"
+    And the server received 3 requests
+    And Error() of int iterator returns nil
+
+  Scenario: FromPages prefetches the next page in the background
+    Given a paginated server with 3 values per page from 1 to 7
+    And pages are prefetched
+    When FromPages is called
+    And Next() of the int iterator is called 1 times
+    Then the server eventually received 2 requests
+    And calling Next() until false is returned should return the following values: "2,3,4,5,6,7"
+    And the server received 3 requests
+
+  Scenario: FromPages retries transient errors
+    Given a paginated server with 3 values per page from 1 to 4
+    And the server fails the first 2 requests with status 503
+    And failed fetches are retried 2 times
+    When FromPages is called
+    Then calling Next() until false is returned should return the following values: "1,2,3,4"
+    And Error() of int iterator returns nil
+    And the server received 4 requests
+
+  Scenario: FromPages reports fetch errors through Error()
+    Given a paginated server with 3 values per page from 1 to 4
+    And the server fails the first 3 requests with status 500
+    And failed fetches are retried 2 times
+    When FromPages is called
+    Then Next() returns true 0 times and then returns false
+    And Error() of int iterator returns an error
+    And the server received 3 requests
+
+  Scenario: FromPages stops fetching when it is closed
+    Given a paginated server with 3 values per page from 1 to 7
+    And pages are prefetched
+    When FromPages is called
+    And Next() of the int iterator is called 1 times
+    Then the server eventually received 2 requests
+    When the int iterator is closed
+    Then Next() returns true 0 times and then returns false
+    And the server received 2 requests
@@ -0,0 +1,67 @@
+Feature: ParallelMap performs the map operation on multiple goroutines
+
+  Scenario: ParallelMap returns the mapped values in the order of the source
+    Given a start value of 1
+    And an end value of 8
+    When Sequence is called
+    And ParallelMap is called with 4 workers and a slow map function that converts the int to a string
+    Then calling Next() until false is returned should return the following strings:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+      | 5 |
+      | 6 |
+      | 7 |
+      | 8 |
+    And Error() of string iterator returns nil
+    And at most 4 map calls ran at the same time
+    And no goroutines of the parallel map are running
+
+  Scenario: ParallelMapUnordered returns all mapped values
+    Given a start value of 1
+    And an end value of 8
+    When Sequence is called
+    And ParallelMapUnordered is called with 3 workers and a slow map function that converts the int to a string
+    Then the sorted values of the string iterator are: "1,2,3,4,5,6,7,8"
+    And at most 3 map calls ran at the same time
+    And no goroutines of the parallel map are running
+
+  Scenario: ParallelMap stops at the first error of the map function
+    Given a start value of 1
+    And an end value of 8
+    When Sequence is called
+    And ParallelMap is called with 4 workers and a map function that fails on 5
+    Then calling Next() until false is returned should return the following strings:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+    And Error() of string iterator returns an error
+    And no goroutines of the parallel map are running
+
+  Scenario: ParallelMap handles errors in source iterator
+    Given an Iterable in an error state
+    When ParallelMap is called with 4 workers and a slow map function that converts the int to a string
+    Then Error() of string iterator returns nil until Next() is called
+    And Error() of string iterator returns an error
+
+  Scenario: ParallelMap does not leak goroutines when the iteration is stopped early
+    Given a start value of 1
+    And an end value of 100
+    When Sequence is called
+    And ParallelMap is called with 4 workers and a slow map function that converts the int to a string
+    And Next() of the parallel map is called 3 times
+    And Stop of the parallel map is called
+    Then no goroutines of the parallel map are running
+    And less than 20 values are pulled from the source
+
+  Scenario: ParallelMapContext stops when the context is cancelled
+    Given a start value of 1
+    And an end value of 100
+    When Sequence is called
+    And a cancelled context
+    And ParallelMapContext is called with 4 workers and a slow map function that converts the int to a string
+    Then Error() of string iterator returns nil until Next() is called
+    And Error() of string iterator returns the context error
+    And no goroutines of the parallel map are running
@@ -0,0 +1,44 @@
+Feature: Peekable allows looking ahead at the next value and pushing values back
+
+  Scenario: Peek returns the next value without advancing the iteration
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Peekable is called
+    Then Peek returns 1
+    And Peek returns 1
+    And calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 2 |
+
+  Scenario: NextIf only returns the next value when the predicate returns true
+    Given an Iterable with the following values:
+      | 1 |
+      | 5 |
+      | 2 |
+    When Peekable is called
+    Then NextIf with a predicate that selects values less than 3 returns 1
+    And NextIf with a predicate that selects values less than 3 returns nothing
+    And calling Next() until false is returned should return the following integers:
+      | 5 |
+      | 2 |
+
+  Scenario: PushBack pushes any number of values back
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Peekable is called
+    And Next() is called once
+    And 8 is pushed back
+    And 9 is pushed back
+    Then Peek returns 9
+    And calling Next() until false is returned should return the following integers:
+      | 9 |
+      | 8 |
+      | 2 |
+
+  Scenario: Peek at the end of the iteration makes the error of the source visible
+    Given an Iterable in an error state
+    When Peekable is called
+    Then Peek returns nothing
+    And Error() of int iterator returns an error
@@ -0,0 +1,111 @@
+Feature: Queries stop pulling values as soon as the answer is known
+
+  Scenario: Find returns the first matching value of an endless sequence
+    Given an endless sequence starting at 1
+    When Find is called with a predicate that selects values greater than 3
+    Then the query result is 4
+
+  Scenario: Find stops pulling values when the value is found
+    Given a closed channel with the following values:
+      | 1 |
+      | 5 |
+      | 2 |
+    When FromChannel is called
+    And Find is called with a predicate that selects values greater than 3
+    Then the query result is 5
+    And the following values are received on the channel
+      | 2 |
+
+  Scenario: Find keeps not found separate from an iteration error
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Find is called with a predicate that selects values greater than 3
+    Then the query found nothing without an error
+
+    Given an Iterable in an error state
+    When Find is called with a predicate that selects values greater than 3
+    Then the query found nothing and returned an error
+
+  Scenario: FindIndex returns the position of the first matching value
+    Given an endless sequence starting at 1
+    When FindIndex is called with a predicate that selects values greater than 3
+    Then the query result is 3
+
+    Given an Iterable with the following values:
+      | 1 |
+    When FindIndex is called with a predicate that selects values greater than 3
+    Then the query result is -1
+    And the query found nothing without an error
+
+  Scenario Outline: Any, All and None answer questions about the values
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When <query> is called with a predicate that selects values greater than <n>
+    Then the query answer is <answer>
+
+    Examples:
+      | query | n | answer |
+      | Any   | 2 | true   |
+      | Any   | 3 | false  |
+      | All   | 0 | true   |
+      | All   | 1 | false  |
+      | None  | 3 | true   |
+      | None  | 2 | false  |
+
+  Scenario: Any, All and None stop at the first value that answers the question
+    Given an endless sequence starting at 1
+    When Any is called with a predicate that selects values greater than 2
+    Then the query answer is true
+
+    Given an endless sequence starting at 1
+    When All is called with a predicate that selects values greater than 2
+    Then the query answer is false
+
+    Given an endless sequence starting at 1
+    When None is called with a predicate that selects values greater than 2
+    Then the query answer is false
+
+  Scenario: Any handles errors in source iterator
+    Given an Iterable in an error state
+    When Any is called with a predicate that selects values greater than 2
+    Then the query answer is false
+    And the query returned an error
+
+  Scenario: First, Last and Nth return the value at a position
+    Given an endless sequence starting at 1
+    When First is called
+    Then the query result is 1
+
+    Given an endless sequence starting at 1
+    When Nth is called with 4
+    Then the query result is 5
+
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When Last is called
+    Then the query result is 3
+
+    Given an Iterable with the following values:
+      | 1 |
+    When Nth is called with 1
+    Then the query found nothing without an error
+
+    Given an empty Iterable
+    When First is called
+    Then the query found nothing without an error
+
+    Given an Iterable in an error state
+    When Last is called
+    Then the query found nothing and returned an error
+
+  Scenario: Count returns the number of values
+    Given a start value of 1
+    And an end value of 7
+    When Sequence is called
+    And Count is called
+    Then the query result is 7
@@ -0,0 +1,82 @@
+Feature: FromLines, FromDelimited and FromScanner read tokens from an io.Reader
+
+  Scenario: FromLines trims the line endings
+    Given a reader with the text "one\ntwo\r\n\nthree"
+    When FromLines is called
+    Then the following tokens are read: ["one" "two" "" "three"]
+    And Error() of string iterator returns nil
+
+  Scenario: FromLines keeps the line endings
+    Given a reader with the text "one\ntwo\r\n\nthree"
+    And line endings are kept
+    When FromLines is called
+    Then the following tokens are read: ["one\n" "two\r\n" "\n" "three"]
+
+  Scenario: FromLines returns no lines for an empty reader
+    Given a reader with the text ""
+    When FromLines is called
+    Then the following tokens are read: []
+    And Error() of string iterator returns nil
+
+  Scenario: FromLines stops with bufio.ErrTooLong when a line is larger than the maximum token size
+    Given a reader with the text "short\nthis line is too long\nshort"
+    And a maximum token size of 10
+    When FromLines is called
+    Then the following tokens are read: ["short"]
+    And Error() of the string iterator returns bufio.ErrTooLong
+
+  Scenario: FromLines accepts lines of exactly the maximum token size
+    Given a reader with the text "abcde\nf\r\nabcde\r\nabcde"
+    And a maximum token size of 5
+    When FromLines is called
+    Then the following tokens are read: ["abcde" "f" "abcde" "abcde"]
+    And Error() of string iterator returns nil
+
+    Given a reader with the text "abcde\r\nabcde\n"
+    And a maximum token size of 5
+    And line endings are kept
+    When FromLines is called
+    Then the following tokens are read: ["abcde\r\n" "abcde\n"]
+    And Error() of string iterator returns nil
+
+  Scenario: FromLines rejects lines of one byte more than the maximum token size
+    Given a reader with the text "abcde\nabcdef\n"
+    And a maximum token size of 5
+    When FromLines is called
+    Then the following tokens are read: ["abcde"]
+    And Error() of the string iterator returns bufio.ErrTooLong
+
+    Given a reader with the text "abcde\nabcdef"
+    And a maximum token size of 5
+    When FromLines is called
+    Then the following tokens are read: ["abcde"]
+    And Error() of the string iterator returns bufio.ErrTooLong
+
+  Scenario: FromDelimited accepts records of exactly the maximum token size
+    Given a reader with the text "abc;de;abc"
+    And a maximum token size of 3
+    And line endings are kept
+    When FromDelimited is called with the delimiter ";"
+    Then the following tokens are read: ["abc;" "de;" "abc"]
+    And Error() of string iterator returns nil
+
+  Scenario: FromLines returns the error of the reader
+    Given a reader that fails after the text "one\ntwo\n"
+    When FromLines is called
+    Then the following tokens are read: ["one" "two"]
+    And Error() of the string iterator returns the error of the reader
+
+  Scenario: FromDelimited splits records at the delimiter
+    Given a reader with the text "a;b;;c;"
+    When FromDelimited is called with the delimiter ";"
+    Then the following tokens are read: ["a" "b" "" "c"]
+
+    Given a reader with the text "a;b;;c"
+    And line endings are kept
+    When FromDelimited is called with the delimiter ";"
+    Then the following tokens are read: ["a;" "b;" ";" "c"]
+
+  Scenario: FromScanner uses the split function of the scanner
+    Given a reader with the text "the quick  brown\nfox"
+    When FromScanner is called with the word split function
+    Then the following tokens are read: ["the" "quick" "brown" "fox"]
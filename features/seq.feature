@@ -0,0 +1,64 @@
+Feature: Iterables can be used with range-over-func and iter.Seq can be used as an Iterable
+
+  Scenario: Seq yields all values of an Iterable in a range loop
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When the Iterable is ranged over with Seq
+    Then the ranged values are: "1,2,3"
+
+  Scenario: Seq stops pulling values when the range loop is exited early
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 4 |
+    When the Iterable is ranged over with Seq until 2 values are received
+    Then the ranged values are: "1,2"
+    And calling Next() until false is returned should return the following integers:
+      | 3 |
+      | 4 |
+
+  Scenario: SeqErr yields the error of an Iterable in an error state
+    Given an Iterable in an error state
+    When the Iterable is ranged over with SeqErr
+    Then the ranged error is not nil
+
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When the Iterable is ranged over with SeqErr
+    Then the ranged values are: "1,2"
+    And the ranged error is nil
+
+  Scenario: FromSeq returns an Iterable that iterates an iter.Seq
+    Given a slice with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When FromSeq is called with the values of the slice
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 2 |
+      | 3 |
+    And Error() of int iterator returns nil
+    And the Seq has finished
+
+  Scenario: FromSeq2 returns an Iterable that iterates an iter.Seq2 as pairs
+    Given a slice with the following values:
+      | 4 |
+      | 5 |
+    When FromSeq2 is called with the indexes and values of the slice
+    Then the returned pairs are: "0:4,1:5"
+
+  Scenario: Stop releases the iter.Seq when the iteration is abandoned early
+    Given a slice with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When FromSeq is called with the values of the slice
+    And Next() is called once
+    And Stop is called
+    Then the Seq has finished
+    And Next() returns true 0 times and then returns false
@@ -0,0 +1,171 @@
+Feature: SizeHint tells how many values an Iterable will return
+
+  Scenario: FromSlice knows exactly how many values remain
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    Then SizeHint of the int iterator returns 3, 3 and true
+    When Next() of the int iterator is called 2 times
+    Then SizeHint of the int iterator returns 1, 1 and true
+    When Next() of the int iterator is called 2 times
+    Then SizeHint of the int iterator returns 0, 0 and true
+
+  Scenario: Sequence knows exactly how many values remain
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    Then SizeHint of the int iterator returns 10, 10 and true
+
+  Scenario: Map passes the size hint unchanged
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    And a map function that multiples the values and converts the int to a string, prefixed with test
+    When Map is called
+    Then SizeHint of the string iterator returns 2, 2 and true
+
+  Scenario: Filter only knows the upper bound
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a predicate that only selects odd numbers
+    When Filter is called
+    Then SizeHint of the int iterator returns 0, 3 and false
+
+  Scenario: Take, Skip and StepBy adjust the size hint
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    And Skip is called with 2
+    Then SizeHint of the int iterator returns 8, 8 and true
+    When StepBy is called with 3
+    Then SizeHint of the int iterator returns 3, 3 and true
+    When Next() of the int iterator is called 1 times
+    Then SizeHint of the int iterator returns 2, 2 and true
+    When Take is called with 1
+    Then SizeHint of the int iterator returns 1, 1 and true
+
+  Scenario: Take limits an unknown size hint
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a predicate that only selects odd numbers
+    When Filter is called
+    And Take is called with 2
+    Then SizeHint of the int iterator returns 0, 2 and false
+
+  Scenario: Chunk and Window count the slices
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    And Chunk is called with 3
+    Then SizeHint of the slice iterator returns 4, 4 and true
+
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    And Window is called with size 4 and step 2
+    Then SizeHint of the slice iterator returns 4, 4 and true
+
+  Scenario: Concat adds the size hints
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Concat is called with an Iterable with the following values:
+      | 3 |
+    Then SizeHint of the int iterator returns 3, 3 and true
+
+  Scenario: Zip returns the size hint of the shortest Iterable
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a second Iterable with the following strings:
+      | a |
+      | b |
+    When Zip is called
+    Then SizeHint of the zipped iterator returns 2, 2 and true
+
+  Scenario: Iterables that do not implement SizeHinter have an unknown size
+    Given an Iterable in an error state
+    Then SizeHint of the int iterator returns 0, -1 and false
+
+  Scenario: FromChannel knows the number of buffered values
+    Given a buffered channel with the following values:
+      | 1 |
+      | 2 |
+    When FromChannel is called
+    Then SizeHint of the int iterator returns 2, -1 and false
+    When Next() of the int iterator is called 1 times
+    Then SizeHint of the int iterator returns 1, -1 and false
+
+  Scenario: ToSlice preallocates the slice
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    And ToSlice is called
+    Then the capacity of the returned slice is 10
+
+  Scenario: ToSlice returns nil for an empty Iterable
+    Given an empty Iterable
+    When ToSlice is called
+    Then the returned slice is nil
+
+  Scenario: Appending preallocates the slice
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    And Collect is called with Appending
+    Then the capacity of the returned slice is 10
+
+  Scenario: Take with a negative count has an empty size hint
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Take is called with -1
+    Then SizeHint of the int iterator returns 0, 0 and true
+    When Collect is called with Appending
+    Then the returned slice is nil
+
+  Scenario: Skip with a negative count skips nothing
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+    When Skip is called with -1
+    Then SizeHint of the int iterator returns 2, 2 and true
+
+  Scenario: StepBy does not overflow the size hint of an infinite Iterable
+    When Repeat is called with 1
+    And StepBy is called with 2
+    Then SizeHint of the int iterator returns 4611686018427387904, -1 and false
+    When Take is called with 3
+    And Collect is called with Appending
+    Then the capacity of the returned slice is 3
+
+  Scenario: Chunk does not overflow the size hint of an infinite Iterable
+    When Repeat is called with 1
+    And Chunk is called with 10
+    Then SizeHint of the slice iterator returns 922337203685477581, -1 and false
+    When Take of the slice iterator is called with 3
+    Then Collect with Appending of the slice iterator returns 3 slices
+
+  Scenario: FromSeq knows nothing about the size until the iteration has ended
+    Given a slice with the following values:
+      | 1 |
+      | 2 |
+    When FromSeq is called with the values of the slice
+    Then SizeHint of the int iterator returns 0, -1 and false
+    When Next() of the int iterator is called 3 times
+    Then SizeHint of the int iterator returns 0, 0 and true
+
+  Scenario: Batch knows nothing about the size until the channel is closed
+    Given a buffered channel with the following values:
+      | 1 |
+      | 2 |
+    When Batch is called with size 2 and a duration of 1 hour
+    Then SizeHint of the slice iterator returns 0, -1 and false
+    And the returned slices are: "1,2"
+    And SizeHint of the slice iterator returns 0, 0 and true
@@ -0,0 +1,108 @@
+Feature: Slicing operators limit and offset an Iterable lazily
+
+  Scenario Outline: Take returns at most n values
+    Given a start value of 1
+    And an end value of 5
+    When Sequence is called
+    And Take is called with <n>
+    Then calling Next() until false is returned should return the following values: "<results>"
+
+    Examples:
+      | n | results   |
+      | 1 | 1         |
+      | 3 | 1,2,3     |
+      | 5 | 1,2,3,4,5 |
+      | 9 | 1,2,3,4,5 |
+
+  Scenario: Take never pulls more values than it returns
+    Given a closed channel with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    When FromChannel is called
+    And Take is called with 2
+    Then Next() returns true 2 times and then returns false
+    And the following values are received on the channel
+      | 3 |
+
+  Scenario Outline: Skip skips the first n values
+    Given a start value of 1
+    And an end value of 5
+    When Sequence is called
+    And Skip is called with <n>
+    Then calling Next() until false is returned should return the following values: "<results>"
+
+    Examples:
+      | n | results   |
+      | 0 | 1,2,3,4,5 |
+      | 1 | 2,3,4,5   |
+      | 4 | 5         |
+
+  Scenario: Skip returns no values when the source has less than n values
+    Given a start value of 1
+    And an end value of 3
+    When Sequence is called
+    And Skip is called with 5
+    Then Next() returns true 0 times and then returns false
+
+  Scenario: TakeWhile returns values until the predicate fails and stops pulling
+    Given a closed channel with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 1 |
+    When FromChannel is called
+    And TakeWhile is called with a predicate that selects values less than 3
+    Then calling Next() until false is returned should return the following integers:
+      | 1 |
+      | 2 |
+    And the following values are received on the channel
+      | 1 |
+
+  Scenario: DropWhile skips values until the predicate fails
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+      | 1 |
+    When DropWhile is called with a predicate that selects values less than 3
+    Then calling Next() until false is returned should return the following integers:
+      | 3 |
+      | 1 |
+
+  Scenario Outline: StepBy returns the first and every k-th value
+    Given a start value of 1
+    And an end value of 7
+    When Sequence is called
+    And StepBy is called with <k>
+    Then calling Next() until false is returned should return the following values: "<results>"
+
+    Examples:
+      | k | results       |
+      | 0 | 1,2,3,4,5,6,7 |
+      | 1 | 1,2,3,4,5,6,7 |
+      | 2 | 1,3,5,7       |
+      | 3 | 1,4,7         |
+      | 4 | 1,5           |
+      | 9 | 1             |
+
+  Scenario: Slicing operators handle errors in source iterator
+    Given an Iterable in an error state
+    When Take is called with 2
+    Then Error() of int iterator returns an error
+
+    Given an Iterable in an error state
+    When Skip is called with 2
+    Then Error() of int iterator returns an error
+
+    Given an Iterable in an error state
+    When TakeWhile is called with a predicate that selects values less than 3
+    Then Error() of int iterator returns an error
+
+    Given an Iterable in an error state
+    When DropWhile is called with a predicate that selects values less than 3
+    Then Error() of int iterator returns an error
+
+    Given an Iterable in an error state
+    When StepBy is called with 2
+    Then Error() of int iterator returns an error
@@ -0,0 +1,47 @@
+Feature: FromRows returns the rows of a database query
+
+  Background:
+    Given a database table "accounts" with the rows:
+      | id | name  | email             | extra |
+      | 1  | alice | alice@example.com | x     |
+      | 2  | bob   | NULL              | y     |
+      | 3  | carol | carol@example.com | z     |
+
+  Scenario: ScanStruct maps the columns to the struct fields
+    When FromRows is called for "accounts" with ScanStruct
+    And the accounts are collected
+    Then the result is "[1:alice:alice@example.com 2:bob:NULL 3:carol:carol@example.com]"
+    And no error is returned
+    And the rows are closed
+
+  Scenario: FromRows uses the scan function
+    Given a database table "names" with the rows:
+      | id | name  |
+      | 1  | alice |
+      | 2  | bob   |
+    When FromRows is called for "names" with a scan function that reads the name
+    And the names are collected
+    Then the result is "alice,bob"
+    And the rows are closed
+
+  Scenario: FromRows closes the rows when the iteration stops early
+    When FromRows is called for "accounts" with ScanStruct
+    And the first 1 accounts are taken
+    Then the result is "[1:alice:alice@example.com]"
+    And no error is returned
+    And the rows are closed
+
+  Scenario: FromRows returns the error of the rows
+    Given reading the table "accounts" fails at row 2
+    When FromRows is called for "accounts" with ScanStruct
+    And the accounts are collected
+    Then the result is "[1:alice:alice@example.com 2:bob:NULL]"
+    And the error of the rows is returned
+    And the rows are closed
+
+  Scenario: FromRows returns scan errors
+    When FromRows is called for "accounts" with a scan function that reads the name
+    And the names are collected
+    Then the result is ""
+    And a scan error is returned
+    And the rows are closed
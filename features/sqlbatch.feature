@@ -0,0 +1,66 @@
+Feature: ToSQLBatches writes the values of an Iterable to a database in batches
+
+  Background:
+    Given a start value of 1
+    And an end value of 5
+    When Sequence is called
+
+  Scenario: ToSQLBatches writes each batch in a transaction
+    When ToSQLBatches is called with batch size 2
+    Then no error is returned
+    And the executed statements are:
+      | INSERT INTO numbers VALUES (?),(?)[1 2] |
+      | COMMIT                                  |
+      | INSERT INTO numbers VALUES (?),(?)[3 4] |
+      | COMMIT                                  |
+      | INSERT INTO numbers VALUES (?)[5]       |
+      | COMMIT                                  |
+
+  Scenario: ToSQLBatches stops at the first failed batch
+    Given statements with the value 3 fail
+    When ToSQLBatches is called with batch size 2
+    Then BatchErrors are returned for the values "2-4"
+    And the executed statements are:
+      | INSERT INTO numbers VALUES (?),(?)[1 2] |
+      | COMMIT                                  |
+      | INSERT INTO numbers VALUES (?),(?)[3 4] |
+      | ROLLBACK                                |
+
+  Scenario: ToSQLBatches continues after a failed batch
+    Given statements with the value 3 fail
+    And failed batches do not stop the writing
+    When ToSQLBatches is called with batch size 1
+    Then BatchErrors are returned for the values "2-3"
+    And the executed statements are:
+      | INSERT INTO numbers VALUES (?)[1] |
+      | COMMIT                            |
+      | INSERT INTO numbers VALUES (?)[2] |
+      | COMMIT                            |
+      | INSERT INTO numbers VALUES (?)[3] |
+      | ROLLBACK                          |
+      | INSERT INTO numbers VALUES (?)[4] |
+      | COMMIT                            |
+      | INSERT INTO numbers VALUES (?)[5] |
+      | COMMIT                            |
+
+  Scenario: ToSQLBatches returns the errors of all failed batches
+    Given statements with the value 3 fail
+    And the next 1 statements fail
+    And failed batches do not stop the writing
+    When ToSQLBatches is called with batch size 2
+    Then BatchErrors are returned for the values "0-2,2-4"
+
+  Scenario: ToSQLBatches retries failed batches
+    Given the next 2 statements fail
+    And failed batches are retried 2 times
+    When ToSQLBatches is called with batch size 3
+    Then no error is returned
+    And the executed statements are:
+      | INSERT INTO numbers VALUES (?),(?),(?)[1 2 3] |
+      | ROLLBACK                                      |
+      | INSERT INTO numbers VALUES (?),(?),(?)[1 2 3] |
+      | ROLLBACK                                      |
+      | INSERT INTO numbers VALUES (?),(?),(?)[1 2 3] |
+      | COMMIT                                        |
+      | INSERT INTO numbers VALUES (?),(?)[4 5]       |
+      | COMMIT                                        |
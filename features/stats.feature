@@ -0,0 +1,119 @@
+Feature: Statistical aggregations reduce an Iterable of numbers to a statistic
+
+  Scenario: Sum returns the sum of all values
+    Given a start value of 1
+    And an end value of 10
+    When Sequence is called
+    And Sum is called
+    Then the statistic is 55
+
+  Scenario: Min and Max return the smallest and largest value
+    Given an Iterable with the following values:
+      | 3  |
+      | -1 |
+      | 7  |
+      | 2  |
+    When Min is called
+    Then the statistic is -1
+
+    Given an Iterable with the following values:
+      | 3  |
+      | -1 |
+      | 7  |
+      | 2  |
+    When Max is called
+    Then the statistic is 7
+
+  Scenario: Min and Max report that there is no value when the Iterable has no values
+    Given an empty Iterable
+    When Min is called
+    Then no statistic is found
+
+  Scenario: MinBy and MaxBy return the first value with the smallest and largest key
+    Given a second Iterable with the following strings:
+      | pear   |
+      | fig    |
+      | banana |
+      | kiwi   |
+      | cherry |
+      | lime   |
+    When MinBy is called with the length as key
+    Then the found string is "fig"
+
+    Given a second Iterable with the following strings:
+      | pear   |
+      | fig    |
+      | banana |
+      | kiwi   |
+      | cherry |
+      | lime   |
+    When MaxBy is called with the length as key
+    Then the found string is "banana"
+
+  Scenario: Mean, Variance and StdDev return the population statistics
+    Given an Iterable with the following values:
+      | 2 |
+      | 4 |
+      | 4 |
+      | 4 |
+      | 5 |
+      | 5 |
+      | 7 |
+      | 9 |
+    When Mean is called
+    Then the statistic is 5
+
+    Given an Iterable with the following values:
+      | 2 |
+      | 4 |
+      | 4 |
+      | 4 |
+      | 5 |
+      | 5 |
+      | 7 |
+      | 9 |
+    When Variance is called
+    Then the statistic is 4
+
+    Given an Iterable with the following values:
+      | 2 |
+      | 4 |
+      | 4 |
+      | 4 |
+      | 5 |
+      | 5 |
+      | 7 |
+      | 9 |
+    When StdDev is called
+    Then the statistic is 2
+
+  Scenario: Variance is numerically stable for large values with a small spread
+    Given an Iterable with the following values:
+      | 1000000004 |
+      | 1000000007 |
+      | 1000000013 |
+      | 1000000016 |
+    When Variance is called
+    Then the statistic is 22.5
+
+  Scenario Outline: Percentile approximates the value at a percentile in bounded memory
+    Given a start value of 1
+    And an end value of 100000
+    When Sequence is called
+    And Percentile is called with <p>
+    Then the statistic is within 0.5% of <value>
+
+    Examples:
+      | p    | value  |
+      | 1    | 1000   |
+      | 50   | 50000  |
+      | 90   | 90000  |
+      | 99   | 99000  |
+      | 99.9 | 99900  |
+      | 100  | 100000 |
+
+  Scenario: Statistics handle errors in source iterator
+    Given an Iterable in an error state
+    When Mean is called
+    Then no statistic is found
+    And the statistic returned an error
@@ -0,0 +1,95 @@
+Feature: FromFS walks a file system lazily
+
+  Background:
+    Given a file system with the files:
+      | b.go         |
+      | a/x.go       |
+      | a/y.txt      |
+      | a/deep/z.go  |
+      | c/w.go       |
+
+  Scenario: FromFS returns the entries in the order of fs.WalkDir
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/,a/deep/,a/deep/z.go,a/x.go,a/y.txt,b.go,c/,c/w.go"
+    And Error() of the walk returns nil
+
+  Scenario: FromFS walks from a root directory and reports the depth
+    When FromFS is called with root "a"
+    Then the walked depths are: "0,1,2,1,1"
+
+  Scenario: FromFS limits the depth
+    Given a maximum depth of 1
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/,b.go,c/"
+
+  Scenario: FromFS includes files by pattern and excludes files and directories by pattern
+    Given the walk includes "*.go"
+    And the walk excludes "deep"
+    And the walk excludes "w.go"
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/,a/x.go,b.go,c/"
+
+  Scenario: A directory is skipped from inside the pipeline
+    When FromFS is called with root "."
+    And directories named "a" are skipped from the pipeline
+    Then the walked paths are: "./,a/,b.go,c/,c/w.go"
+
+  Scenario: The rest of a directory is skipped from inside the pipeline
+    When FromFS is called with root "."
+    And the rest of the directory is skipped after "z.go"
+    Then the walked paths are: "./,a/,a/deep/,a/deep/z.go,a/x.go,a/y.txt,b.go,c/,c/w.go"
+
+    When FromFS is called with root "."
+    And the rest of the directory is skipped after "x.go"
+    Then the walked paths are: "./,a/,a/deep/,a/deep/z.go,a/x.go,b.go,c/,c/w.go"
+
+  Scenario: Skipping a directory at the maximum depth does not skip its siblings
+    Given a maximum depth of 2
+    When FromFS is called with root "."
+    And directories named "deep" are skipped from the pipeline
+    Then the walked paths are: "./,a/,a/deep/,a/x.go,a/y.txt,b.go,c/,c/w.go"
+
+  Scenario: FromFS stops at the first error
+    Given reading the directory "a" fails
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/"
+    And Error() of the walk returns the read error
+
+  Scenario: FromFS passes errors to the callback and continues
+    Given reading the directory "a" fails
+    And walk errors are collected by the callback
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/,b.go,c/,c/w.go"
+    And Error() of the walk returns nil
+    And the callback received errors for "a"
+
+  Scenario: FromFS returns an error for a root that does not exist
+    When FromFS is called with root "missing"
+    Then the walked paths are: ""
+    And Error() of the walk returns an error
+
+  Scenario: FromFS does not follow symbolic links by default
+    Given a directory on disk with a symbolic link loop
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/,a/b/,a/b/file.txt,a/b/up,link"
+
+  Scenario: FromFS follows symbolic links and detects loops
+    Given a directory on disk with a symbolic link loop
+    And symbolic links are followed
+    When FromFS is called with root "."
+    Then the walked paths are: "./,a/,a/b/,a/b/file.txt,a/b/up/,link/,link/file.txt,link/up/,link/up/b/,link/up/b/file.txt,link/up/b/up/"
+    And Error() of the walk returns nil
+
+  Scenario: Skipping a symbolic link to an ancestor does not skip its siblings
+    Given a directory on disk with a symbolic link loop
+    And symbolic links are followed
+    When FromFS is called with root "."
+    And directories named "up" are skipped from the pipeline
+    Then the walked paths are: "./,a/,a/b/,a/b/file.txt,a/b/up/,link/,link/file.txt,link/up/"
+
+  Scenario: Following symbolic links requires os.DirFS
+    Given a file system with a symbolic link
+    And symbolic links are followed
+    When FromFS is called with root "."
+    Then the walked paths are: "./,dir/,dir/file.txt"
+    And Error() of the walk returns an error
@@ -0,0 +1,83 @@
+Feature: Zip combines two Iterables in pairs and Unzip splits them again
+
+  Scenario: Zip stops at the shortest Iterable
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a second Iterable with the following strings:
+      | a |
+      | b |
+    When Zip is called
+    Then the zipped pairs are: "1:a,2:b"
+    And Error() of the zipped iterator returns nil
+
+  Scenario: ZipLongest fills the values of the shortest Iterable
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a second Iterable with the following strings:
+      | a |
+    When ZipLongest is called with fill values 0 and "-"
+    Then the zipped pairs are: "1:a,2:-,3:-"
+
+    Given an Iterable with the following values:
+      | 1 |
+    And a second Iterable with the following strings:
+      | a |
+      | b |
+    When ZipLongest is called with fill values 0 and "-"
+    Then the zipped pairs are: "1:a,0:b"
+
+  Scenario: Zip and ZipLongest report the error of the first Iterable first
+    Given a first Iterable in an error state
+    And a second Iterable in an error state
+    When Zip is called
+    Then the zipped pairs are: ""
+    And Error() of the zipped iterator returns the error of the first Iterable
+
+    Given an Iterable with the following values:
+      | 1 |
+    And a second Iterable in an error state
+    When ZipLongest is called with fill values 0 and "-"
+    Then the zipped pairs are: ""
+    And Error() of the zipped iterator returns the error of the second Iterable
+
+  Scenario: Unzip splits pairs into two Iterables backed by a shared buffer
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a second Iterable with the following strings:
+      | a |
+      | b |
+      | c |
+    When Zip is called
+    And Unzip is called
+    Then the second unzipped Iterable returns: "a,b,c"
+    And the first unzipped Iterable returns: "1,2,3"
+
+  Scenario: Unzip handles errors in source iterator
+    Given a first Iterable in an error state
+    And a second Iterable in an error state
+    When Zip is called
+    And Unzip is called
+    Then both unzipped Iterables return the error of the first Iterable
+
+  Scenario: Unzip closes the source when both Iterables are closed
+    Given an Iterable with the following values:
+      | 1 |
+      | 2 |
+      | 3 |
+    And a second Iterable with the following strings:
+      | a |
+      | b |
+      | c |
+    When Zip is called
+    And the zipped Iterable counts the calls to Close
+    And Unzip is called
+    And First is called on the first unzipped Iterable
+    Then the zipped Iterable is closed 0 times
+    And the second unzipped Iterable returns: "a,b,c"
+    And the zipped Iterable is closed 1 times
@@ -0,0 +1,206 @@
+package iterator
+
+import "math"
+
+// Repeat
+
+// RepeatIterator is a struct the implements an Iterable that returns the same value forever.
+type RepeatIterator[T any] struct {
+	// v contains the value that is repeated
+	v T
+}
+
+// Next returns the repeated value and true.
+func (iter *RepeatIterator[T]) Next() (T, bool) {
+	return iter.v, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The RepeatIterator never returns an error.
+func (iter *RepeatIterator[T]) Error() error {
+	return nil
+}
+
+// SizeHint returns math.MaxInt as lower bound and an unknown upper bound, because the iteration never ends.
+func (iter *RepeatIterator[T]) SizeHint() (int, int, bool) {
+	return math.MaxInt, -1, false
+}
+
+// Repeat accepts a value and returns a RepeatIterator that returns that value forever. Use Take to limit the
+// number of values.
+func Repeat[T any](v T) *RepeatIterator[T] {
+	return &RepeatIterator[T]{v: v}
+}
+
+// Cycle
+
+// CycleIterator is a struct the implements an Iterable that returns the values of the source Iterable, and then
+// replays them forever.
+type CycleIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// buf contains the values of the first pass
+	buf []T
+	// idx contains the position in buf while replaying
+	idx int
+	// replaying is true when the source Iterable is completely iterated
+	replaying bool
+	// err contains the error of the source Iterable
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *CycleIterator[T]) Next() (T, bool) {
+	if !iter.replaying {
+		if v, b := iter.srcItr.Next(); b {
+			iter.buf = append(iter.buf, v)
+			return v, true
+		}
+		iter.replaying = true
+		iter.err = iter.srcItr.Error()
+	}
+	if iter.err != nil || len(iter.buf) == 0 {
+		var t T
+		return t, false
+	}
+	v := iter.buf[iter.idx]
+	iter.idx = (iter.idx + 1) % len(iter.buf)
+	return v, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the source Iterable is returned.
+func (iter *CycleIterator[T]) Error() error {
+	if iter.replaying {
+		return iter.err
+	}
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable.
+func (iter *CycleIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns math.MaxInt as lower bound and an unknown upper bound when there are values to replay.
+// When the source Iterable has no values and no error, nothing is replayed and the size hint is exactly zero.
+func (iter *CycleIterator[T]) SizeHint() (int, int, bool) {
+	if iter.replaying && (iter.err != nil || len(iter.buf) == 0) {
+		return exactHint(0)
+	}
+	if len(iter.buf) > 0 {
+		return math.MaxInt, -1, false
+	}
+	lower, upper, _ := SizeHint(iter.srcItr)
+	if lower > 0 {
+		return math.MaxInt, -1, false
+	}
+	if upper == 0 {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// Cycle accepts an Iterable and returns a CycleIterator that returns the values of the provided Iterable, and then
+// replays them forever. The values of the first pass are buffered, so the provided Iterable is only iterated once.
+// When the provided Iterable has no values or ends with an error, nothing is replayed.
+func Cycle[T any](iter Iterable[T]) *CycleIterator[T] {
+	return &CycleIterator[T]{srcItr: iter}
+}
+
+// Iterate
+
+// IterateIterator is a struct the implements an Iterable that returns a seed value and then the result of applying
+// a function to the previous value, forever.
+type IterateIterator[T any] struct {
+	// v contains the previous value
+	v T
+	// f contains the closure that calculates the next value from the previous value
+	f MapFunc[T, T]
+	// started is true when the seed value has been returned
+	started bool
+}
+
+// Next returns the seed value the first time, and the result of applying the function to the previous value after
+// that, and true.
+func (iter *IterateIterator[T]) Next() (T, bool) {
+	if iter.started {
+		iter.v = iter.f(iter.v)
+	}
+	iter.started = true
+	return iter.v, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The IterateIterator never returns an error.
+func (iter *IterateIterator[T]) Error() error {
+	return nil
+}
+
+// SizeHint returns math.MaxInt as lower bound and an unknown upper bound, because the iteration never ends.
+func (iter *IterateIterator[T]) SizeHint() (int, int, bool) {
+	return math.MaxInt, -1, false
+}
+
+// Iterate accepts a seed value and a MapFunc closure and returns an IterateIterator that returns seed, f(seed),
+// f(f(seed)) and so on forever. The closure is only called when the next value is requested. Use Take or
+// TakeWhile to limit the number of values.
+func Iterate[T any](seed T, f MapFunc[T, T]) *IterateIterator[T] {
+	return &IterateIterator[T]{v: seed, f: f}
+}
+
+// Unfold
+
+// UnfoldFunc is the closure type that is provided to Unfold. It receives the current state and returns a value,
+// the next state and true, or false when the iteration is done.
+type UnfoldFunc[S any, T any] func(state S) (T, S, bool)
+
+// UnfoldIterator is a struct the implements an Iterable that generates values from a state until the UnfoldFunc
+// closure decides to stop.
+type UnfoldIterator[S any, T any] struct {
+	// state contains the current state
+	state S
+	// f contains the closure that generates a value and the next state
+	f UnfoldFunc[S, T]
+	// done is true when the closure returned false
+	done bool
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *UnfoldIterator[S, T]) Next() (T, bool) {
+	var t T
+	if iter.done {
+		return t, false
+	}
+	v, state, b := iter.f(iter.state)
+	if !b {
+		iter.done = true
+		return t, false
+	}
+	iter.state = state
+	return v, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The UnfoldIterator never returns an error.
+func (iter *UnfoldIterator[S, T]) Error() error {
+	return nil
+}
+
+// SizeHint returns exactly zero when the closure returned false, otherwise nothing is known about the number
+// of values.
+func (iter *UnfoldIterator[S, T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// Unfold accepts an initial state and an UnfoldFunc closure and returns an UnfoldIterator that calls the closure
+// with the current state for each value. The iteration stops when the closure returns false, after that the
+// closure is not called again.
+func Unfold[S any, T any](state S, f UnfoldFunc[S, T]) *UnfoldIterator[S, T] {
+	return &UnfoldIterator[S, T]{state: state, f: f}
+}
@@ -0,0 +1,95 @@
+package iterator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleUnfold() {
+	// Generate the Fibonacci numbers below 50. The state holds the next two numbers.
+	fib := Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 50
+	})
+
+	values, _ := ToSlice[int](fib)
+	fmt.Println(values)
+
+	// Output:
+	// [0 1 1 2 3 5 8 13 21 34]
+}
+
+func ExampleIterate() {
+	// Generate an exponential backoff schedule of 5 attempts, starting at 100ms.
+	backoff := Take[time.Duration](Iterate(100*time.Millisecond, func(d time.Duration) time.Duration {
+		return d * 2
+	}), 5)
+
+	_ = ForEach[time.Duration](backoff, func(d time.Duration) {
+		fmt.Println(d)
+	})
+
+	// Output:
+	// 100ms
+	// 200ms
+	// 400ms
+	// 800ms
+	// 1.6s
+}
+
+func ExampleCycle() {
+	// Assign tasks round robin to workers.
+	workers := Cycle[string](FromSlice([]string{"a", "b"}))
+	tasks := Zip[int, string](Sequence(1, 5), workers)
+
+	_ = ForEach[Pair[int, string]](tasks, func(p Pair[int, string]) {
+		fmt.Println(p.First, p.Second)
+	})
+
+	// Output:
+	// 1 a
+	// 2 b
+	// 3 a
+	// 4 b
+	// 5 a
+}
+
+func ExampleRepeat() {
+	values, _ := ToSlice[string](Take[string](Repeat("x"), 3))
+	fmt.Println(values)
+
+	// Output:
+	// [x x x]
+}
+
+// Tests
+
+func repeatIsCalledWith(v int) {
+	t.resultingIntIterator = Repeat(v)
+}
+
+func cycleIsCalled() {
+	t.resultingIntIterator = Cycle(t.resultingIntIterator)
+}
+
+func iterateIsCalledWithSeedAndAFunctionThatDoublesTheValue(seed int) {
+	t.resultingIntIterator = Iterate(seed, func(v int) int {
+		return v * 2
+	})
+}
+
+func unfoldIsCalledWithAFunctionThatCountsDownFrom(start int) {
+	t.resultingIntIterator = Unfold(start, func(s int) (int, int, bool) {
+		return s, s - 1, s > 0
+	})
+}
+
+func initializeGeneratorsScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^Repeat is called with (-?\d+)$`, repeatIsCalledWith)
+	ctx.Step(`^Cycle is called$`, cycleIsCalled)
+	ctx.Step(`^Iterate is called with seed (-?\d+) and a function that doubles the value$`, iterateIsCalledWithSeedAndAFunctionThatDoublesTheValue)
+	ctx.Step(`^Unfold is called with a function that counts down from (-?\d+)$`, unfoldIsCalledWithAFunctionThatCountsDownFrom)
+}
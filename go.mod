@@ -1,6 +1,6 @@
 module github.com/crosscode-nl/iterator
 
-go 1.18
+go 1.23
 
 require github.com/cucumber/godog v0.12.5
 
@@ -1,7 +1,16 @@
 // Package iterator contains an implementation of the map, filter, reduce pattern for Go.
 package iterator
 
+import (
+	"context"
+	"errors"
+	"math"
+)
+
 // Iterable is a generic interface for all iterables.
+// An Iterable that holds resources, like a file or a goroutine, can also implement io.Closer. Close must be safe to
+// call more than once. Iterables that wrap other Iterables forward Close to them, and the terminal operations, like
+// ForEach and ToSlice, close the Iterable when they are done.
 type Iterable[T any] interface {
 	// Next returns the first or next value of T and true if a value is available.
 	// If no more values are available or an error has occurred then a zero value of T and false is returned.
@@ -43,6 +52,11 @@ func (iter *SliceIterator[T]) Error() error {
 	return nil
 }
 
+// SizeHint returns the exact number of values that remain in the slice.
+func (iter *SliceIterator[T]) SizeHint() (int, int, bool) {
+	return exactHint(max(len(iter.values)-iter.idx-1, 0))
+}
+
 // FromSlice creates a SliceIterator that iterates the provided slice.
 func FromSlice[T any](values []T) *SliceIterator[T] {
 	return &SliceIterator[T]{
@@ -63,22 +77,86 @@ func FromReverseSlice[T any](values []T) *SliceIterator[T] {
 
 // ChannelIterator is a generic struct implementing an iterator that iterates over channels.
 type ChannelIterator[T any] struct {
+	// c contains the channel to iterate
 	c <-chan T
+	// ctx contains the context that stops the iteration when it is done, or nil when there is no such context
+	ctx context.Context
+	// cancel contains the function that cancels ctx, or nil when there is no context
+	cancel context.CancelFunc
+	// err contains the error of ctx after the iteration was stopped by it
+	err error
+	// done contains true when the channel is closed, the context is done or Close is called
+	done bool
 }
 
 // Next returns the first or next value of T and true if a value is available.
 // If no more values are available or an error has occurred then a zero value of T and false is returned.
+// When the ChannelIterator has a context, Next stops waiting for a value as soon as the context is done.
 func (iter *ChannelIterator[T]) Next() (v T, r bool) {
-	v, r = <-iter.c
+	if iter.done {
+		return
+	}
+	if iter.ctx == nil {
+		v, r = <-iter.c
+		iter.done = !r
+		return
+	}
+	// The context is only cancelled by the ChannelIterator itself when it is done, so an error of the context is
+	// always the error of the provided context.
+	if iter.err = iter.ctx.Err(); iter.err == nil {
+		select {
+		case v, r = <-iter.c:
+		case <-iter.ctx.Done():
+			iter.err = iter.ctx.Err()
+		}
+	}
+	if !r {
+		iter.done = true
+		iter.cancel()
+	}
 	return
 }
 
 // Error returns nil after Next returned false when the iteration has completed successfully, otherwise
-// an error is returned. The ChannelIterator never returns an error.
+// an error is returned. The ChannelIterator only returns an error when its context is done.
 func (iter *ChannelIterator[T]) Error() error {
+	return iter.err
+}
+
+// Close stops the iteration, Next returns false after Close is called. The channel itself is not closed, because
+// only the sender of a channel should close it. When the ChannelIterator is created with FromChannelContext, Close
+// cancels the context returned by Context, so a sender that selects on it stops. Otherwise, use OnClose to signal
+// the sender when it needs to stop.
+func (iter *ChannelIterator[T]) Close() error {
+	iter.done = true
+	if iter.cancel != nil {
+		iter.cancel()
+	}
 	return nil
 }
 
+// Context returns the context of the ChannelIterator, which is derived from the context provided to
+// FromChannelContext and is cancelled when the iteration ends or the ChannelIterator is closed. The sender of the
+// channel can select on it to stop sending when the values are no longer received. Without a context,
+// context.Background is returned.
+func (iter *ChannelIterator[T]) Context() context.Context {
+	if iter.ctx == nil {
+		return context.Background()
+	}
+	return iter.ctx
+}
+
+// SizeHint returns the number of values buffered in the channel as lower bound, the upper bound is unknown.
+func (iter *ChannelIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	if iter.ctx != nil {
+		return 0, -1, false
+	}
+	return len(iter.c), -1, false
+}
+
 // FromChannel creates a ChannelIterator that iterates the provided channel.
 func FromChannel[T any](c <-chan T) *ChannelIterator[T] {
 	return &ChannelIterator[T]{
@@ -86,6 +164,19 @@ func FromChannel[T any](c <-chan T) *ChannelIterator[T] {
 	}
 }
 
+// FromChannelContext creates a ChannelIterator that iterates the provided channel until the channel is closed or the
+// provided context is done. When the context is done Error returns the error of the context. The ChannelIterator
+// derives its own context, returned by Context, that is cancelled when the iteration ends or the ChannelIterator is
+// closed, so the sender of the channel does not leak when the receiver stops early.
+func FromChannelContext[T any](ctx context.Context, c <-chan T) *ChannelIterator[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	return &ChannelIterator[T]{
+		c:      c,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
 // Algorithms
 // Foreach
 
@@ -93,12 +184,12 @@ func FromChannel[T any](c <-chan T) *ChannelIterator[T] {
 type ForEachFunc[T any] func(T)
 
 // ForEach accepts an Iterable and calls the provided ForEachFunc closure with each value.
-// An error is returned when an error during iteration has occurred.
+// An error is returned when an error during iteration has occurred. The Iterable is closed when the iteration is done.
 func ForEach[T any](iter Iterable[T], f ForEachFunc[T]) error {
 	for v, b := iter.Next(); b; v, b = iter.Next() {
 		f(v)
 	}
-	return iter.Error()
+	return Finish(iter)
 }
 
 // Map
@@ -132,6 +223,16 @@ func (iter *MapIterator[T, R]) Error() error {
 	return iter.srcItr.Error()
 }
 
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *MapIterator[T, R]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the size hint of the source Iterable.
+func (iter *MapIterator[T, R]) SizeHint() (int, int, bool) {
+	return SizeHint(iter.srcItr)
+}
+
 // Map accepts an Iterable and MapFunc closure and creates a MapIterator that
 // will perform the map operation on the values of the provided Iterable and
 // returns the transformed values when iterated.
@@ -175,6 +276,16 @@ func (iter *FilterIterator[T]) Error() error {
 	return iter.srcItr.Error()
 }
 
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *FilterIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the upper bound of the source Iterable, because values can be filtered.
+func (iter *FilterIterator[T]) SizeHint() (int, int, bool) {
+	return upperHint(iter.srcItr)
+}
+
 // Filter accepts an Iterable and PredicateFunc closure and creates a FilterIterator that
 // will perform the filter operation on the values of the provided Iterable and
 // returns the filtered values when iterated.
@@ -191,37 +302,41 @@ func Filter[T any](iter Iterable[T], predicate PredicateFunc[T]) *FilterIterator
 type ReduceFunc[T any, R any] func(R, T) R
 
 // Reduce accepts an Iterable, init value and ReduceFunc and reduces the values of the iterator to a single value by
-// calling the ReduceFunc closure.
+// calling the ReduceFunc closure. The Iterable is closed when the iteration is done.
 func Reduce[T any, R any](iter Iterable[T], init R, reducer ReduceFunc[T, R]) (R, error) {
 	for v, b := iter.Next(); b; v, b = iter.Next() {
 		init = reducer(init, v)
 	}
-	return init, iter.Error()
+	return init, Finish(iter)
 }
 
 // ToSlice
 
-// ToSlice renders the Iterable to a slice.
+// ToSlice renders the Iterable to a slice. The slice is preallocated with the lower bound of the SizeHint of the
+// Iterable. The Iterable is closed when the iteration is done.
 func ToSlice[T any](iter Iterable[T]) ([]T, error) {
 	var result []T
+	if lower, _, _ := SizeHint(iter); lower > 0 {
+		result = make([]T, 0, lower)
+	}
 
 	for v, b := iter.Next(); b; v, b = iter.Next() {
 		result = append(result, v)
 	}
 
-	return result, iter.Error()
+	return result, Finish(iter)
 }
 
 // ToChannel
 
-// ToChannel renders the Iterable to a channel.
+// ToChannel renders the Iterable to a channel. The Iterable is closed when the iteration is done.
 func ToChannel[T any](iter Iterable[T], c chan<- T) error {
 
 	for v, b := iter.Next(); b; v, b = iter.Next() {
 		c <- v
 	}
 
-	return iter.Error()
+	return Finish(iter)
 }
 
 // Generators
@@ -262,6 +377,15 @@ func (g *GeneratingIterator[T]) Error() error {
 	return nil
 }
 
+// SizeHint returns the exact number of values that remain to be generated. When that number does not fit in an int,
+// the lower bound is math.MaxInt and the upper bound is unknown.
+func (g *GeneratingIterator[T]) SizeHint() (int, int, bool) {
+	if n := g.repeat - g.count; n <= math.MaxInt {
+		return exactHint(int(n))
+	}
+	return math.MaxInt, -1, false
+}
+
 // Generate accepts a repeat count and a GeneratorFunc closure and returns a GeneratingIterator that repeats
 // the given repeat times and returns values returned by the GeneratorFunc closure.
 func Generate[T any](p T, r uint64, gf GeneratorFunc[T]) *GeneratingIterator[T] {
@@ -278,6 +402,21 @@ type SignedIntegers interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64
 }
 
+// The Unsigned interface defines all unsigned integer types
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// The Float interface defines all floating point types
+type Float interface {
+	~float32 | ~float64
+}
+
+// The Number interface defines all signed integer, unsigned integer and floating point types
+type Number interface {
+	SignedIntegers | Unsigned | Float
+}
+
 // RepeatingIntegerGenerator accepts en initial value, a repeat value and a step value.
 // The initial value is increased after each iteration step with the step value.
 func RepeatingIntegerGenerator[T SignedIntegers](i T, r uint64, s T) *GeneratingIterator[T] {
@@ -289,31 +428,167 @@ func RepeatingIntegerGenerator[T SignedIntegers](i T, r uint64, s T) *Generating
 	return Generate(t, r, next)
 }
 
+// ErrZeroStep is returned by the checked sequence constructors when the step is zero.
+var ErrZeroStep = errors.New("iterator: step must not be zero")
+
+// ErrSequenceOverflow is returned by the checked sequence constructors when the sequence has more values than
+// fit in an uint64. This only happens for a sequence over the full range of a 64 bit type with a step of 1.
+var ErrSequenceOverflow = errors.New("iterator: sequence has too many values")
+
+// stepSequence returns a GeneratingIterator that returns a sequence of values from start (inclusive) to end
+// (inclusive) with steps of absStep towards end. The distance between start and end and the values are calculated
+// with uint64 arithmetic, which wraps around to the exact values at the limits of T.
+func stepSequence[T SignedIntegers | Unsigned](start T, end T, absStep uint64) (*GeneratingIterator[T], error) {
+	if absStep == 0 {
+		return nil, ErrZeroStep
+	}
+	descending := start > end
+	distance := uint64(end) - uint64(start)
+	if descending {
+		distance = uint64(start) - uint64(end)
+	}
+	n := distance / absStep
+	if n == math.MaxUint64 {
+		return nil, ErrSequenceOverflow
+	}
+	next := func(p T, c uint64, r uint64) T {
+		if descending {
+			return T(uint64(start) - c*absStep)
+		}
+		return T(uint64(start) + c*absStep)
+	}
+	var t T
+	return Generate(t, n+1, next), nil
+}
+
+// absStep returns the absolute value of a signed step as uint64, which also holds the absolute value of the
+// minimum value of T.
+func absStep[T SignedIntegers](step T) uint64 {
+	if step < 0 {
+		return -uint64(step)
+	}
+	return uint64(step)
+}
+
+// must panics with the error when it is not nil, otherwise the GeneratingIterator is returned.
+func must[T any](iter *GeneratingIterator[T], err error) *GeneratingIterator[T] {
+	if err != nil {
+		panic(err)
+	}
+	return iter
+}
+
 // StepSequence accepts signed integer for start and end values. It will return GeneratingIterator
 // that returns a sequence of values from start (inclusive) to end (inclusive).
 // The sequence will increase or decrease the value returned with each iteration step with step.
 // StepSequence will correct the sign of step for generating a sequence from start to end.
+// When end is not reached by a whole number of steps, the last value is the last value before end.
+// StepSequence panics with ErrZeroStep or ErrSequenceOverflow, use CheckedStepSequence to get these as error.
 func StepSequence[T SignedIntegers](start T, end T, step T) *GeneratingIterator[T] {
-	absStep := step
-	if start > end {
-		if step > 0 {
-			step *= -1
-		} else {
-			absStep *= -1
-		}
-		return RepeatingIntegerGenerator(start, uint64((start-end)/absStep)+1, step)
-	}
-	if step < 0 {
-		step *= -1
-		absStep *= -1
-	}
-	return RepeatingIntegerGenerator(start, uint64((end-start)/absStep)+1, step)
+	return must(CheckedStepSequence(start, end, step))
+}
+
+// CheckedStepSequence is StepSequence that returns ErrZeroStep when step is zero and ErrSequenceOverflow when the
+// sequence has more values than fit in an uint64 instead of panicking. The values are exact up to the limits of T.
+func CheckedStepSequence[T SignedIntegers](start T, end T, step T) (*GeneratingIterator[T], error) {
+	return stepSequence(start, end, absStep(step))
 }
 
 // Sequence accepts signed integer for start and end values. It will return GeneratingIterator
 // that returns a sequence of values from start (inclusive) to end (inclusive).
 // The sequence will increase or decrease the value returned with each iteration step with 1.
-// StepSequence will correct the sign of step for generating a sequence from start to end.
+// Sequence panics with ErrSequenceOverflow, use CheckedSequence to get this as error.
 func Sequence[T SignedIntegers](start T, end T) *GeneratingIterator[T] {
 	return StepSequence(start, end, 1)
 }
+
+// CheckedSequence is Sequence that returns ErrSequenceOverflow when the sequence has more values than fit in an
+// uint64 instead of panicking.
+func CheckedSequence[T SignedIntegers](start T, end T) (*GeneratingIterator[T], error) {
+	return CheckedStepSequence(start, end, 1)
+}
+
+// UnsignedStepSequence accepts unsigned integer for start and end values. It will return GeneratingIterator
+// that returns a sequence of values from start (inclusive) to end (inclusive when it is reached by a whole
+// number of steps). The sequence will increase the value returned with each iteration step with step when
+// start is smaller than end, and decrease it with step when start is larger than end. When end is not reached
+// by a whole number of steps, the last value is the last value before end.
+// UnsignedStepSequence panics with ErrZeroStep or ErrSequenceOverflow, use CheckedUnsignedStepSequence to get
+// these as error.
+func UnsignedStepSequence[T Unsigned](start T, end T, step T) *GeneratingIterator[T] {
+	return must(CheckedUnsignedStepSequence(start, end, step))
+}
+
+// CheckedUnsignedStepSequence is UnsignedStepSequence that returns ErrZeroStep when step is zero and
+// ErrSequenceOverflow when the sequence has more values than fit in an uint64 instead of panicking.
+func CheckedUnsignedStepSequence[T Unsigned](start T, end T, step T) (*GeneratingIterator[T], error) {
+	return stepSequence(start, end, uint64(step))
+}
+
+// UnsignedSequence accepts unsigned integer for start and end values. It will return GeneratingIterator
+// that returns a sequence of values from start (inclusive) to end (inclusive).
+// The sequence will increase or decrease the value returned with each iteration step with 1.
+// UnsignedSequence panics with ErrSequenceOverflow, use CheckedUnsignedSequence to get this as error.
+func UnsignedSequence[T Unsigned](start T, end T) *GeneratingIterator[T] {
+	return UnsignedStepSequence(start, end, 1)
+}
+
+// CheckedUnsignedSequence is UnsignedSequence that returns ErrSequenceOverflow when the sequence has more values
+// than fit in an uint64 instead of panicking.
+func CheckedUnsignedSequence[T Unsigned](start T, end T) (*GeneratingIterator[T], error) {
+	return CheckedUnsignedStepSequence(start, end, 1)
+}
+
+// Linspace accepts floating point start and end values and a count. It will return GeneratingIterator
+// that returns n evenly spaced values from start (inclusive) to end (inclusive). Each value is calculated
+// from start and its index, so rounding errors do not accumulate, and the last value is exactly end.
+// When n is 1 only start is returned, when n is 0 no values are returned.
+func Linspace[T Float](start T, end T, n uint64) *GeneratingIterator[T] {
+	next := func(p T, c uint64, r uint64) T {
+		switch c {
+		case 0:
+			return start
+		case r - 1:
+			return end
+		}
+		return T(float64(start) + (float64(end)-float64(start))*float64(c)/float64(r-1))
+	}
+	var t T
+	return Generate(t, n, next)
+}
+
+// Arange accepts floating point start, end and step values. It will return GeneratingIterator that returns
+// a sequence of values from start (inclusive) to end (exclusive). Each value is calculated as start plus its
+// index times step, so rounding errors do not accumulate. On a partial last step end is never returned:
+// the last value is the last value start + i*step that is strictly before end, also when rounding would make
+// the number of steps come out one too large or too small. Arange will correct the sign of step for generating
+// a sequence from start to end. When step is zero, or any of the values is NaN or infinite, no values are returned.
+func Arange[T Float](start T, end T, step T) *GeneratingIterator[T] {
+	s, e, d := float64(start), float64(end), math.Abs(float64(step))
+	if s > e {
+		d = -d
+	}
+	value := func(c uint64) T {
+		return T(s + float64(c)*d)
+	}
+	before := func(v T) bool {
+		return (d > 0 && float64(v) < e) || (d < 0 && float64(v) > e)
+	}
+
+	var n uint64
+	if f := math.Ceil((e - s) / d); f > 0 && f < math.MaxUint64 {
+		n = uint64(f)
+		for n > 0 && !before(value(n-1)) {
+			n--
+		}
+		for before(value(n)) {
+			n++
+		}
+	}
+
+	next := func(p T, c uint64, r uint64) T {
+		return value(c)
+	}
+	var t T
+	return Generate(t, n, next)
+}
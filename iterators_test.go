@@ -247,6 +247,39 @@ func ExampleStepSequence() {
 	// 9
 }
 
+func ExampleUnsignedSequence() {
+	// Generate a range of unsigned IDs, counting down.
+	ids := UnsignedSequence[uint64](1003, 1000)
+
+	_ = ForEach[uint64](ids, func(v uint64) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// 1003
+	// 1002
+	// 1001
+	// 1000
+}
+
+func ExampleLinspace() {
+	// Generate 5 evenly spaced values from 0 to 1, both endpoints are included.
+	values, _ := ToSlice[float64](Linspace(0.0, 1.0, 5))
+	fmt.Println(values)
+
+	// Output:
+	// [0 0.25 0.5 0.75 1]
+}
+
+func ExampleArange() {
+	// Generate values from 0 up to 1 with steps of 0.1. The end is excluded, and no rounding errors accumulate.
+	values, _ := ToSlice[float64](Arange(0.0, 1.0, 0.1))
+	fmt.Println(values)
+
+	// Output:
+	// [0 0.1 0.2 0.30000000000000004 0.4 0.5 0.6000000000000001 0.7000000000000001 0.8 0.9]
+}
+
 func ExampleToSlice() {
 	// Iterators can be turned into slices with ToSlice
 
@@ -323,6 +356,8 @@ type testFixture struct {
 	slice                   []int
 	resultingIntIterator    Iterable[int]
 	resultingStringIterator Iterable[string]
+	resultingFloatIterator  Iterable[float64]
+	sequenceErr             error
 	predicate               PredicateFunc[int]
 	mapper                  MapFunc[int, string]
 	resultingSlice          []int
@@ -580,6 +615,99 @@ func sequenceIsCalled() {
 	t.resultingIntIterator = Sequence(t.start, t.end)
 }
 
+func unsignedStepSequenceIsCalled() {
+	t.resultingIntIterator = Map[uint64](UnsignedStepSequence(uint64(t.start), uint64(t.end), uint64(t.step)), func(v uint64) int {
+		return int(v)
+	})
+}
+
+func unsignedSequenceIsCalled() {
+	t.resultingIntIterator = Map[uint64](UnsignedSequence(uint64(t.start), uint64(t.end)), func(v uint64) int {
+		return int(v)
+	})
+}
+
+func linspaceIsCalledWithAnd(start, end float64, n int) {
+	t.resultingFloatIterator = Linspace(start, end, uint64(n))
+}
+
+func arangeIsCalledWithAnd(start, end, step float64) {
+	t.resultingFloatIterator = Arange(start, end, step)
+}
+
+func theFollowingFloatsAreReturned(expected string) error {
+	results, err := ToSlice(t.resultingFloatIterator)
+	if err != nil {
+		return err
+	}
+	if fmt.Sprint(results) != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, results)
+	}
+	return nil
+}
+
+func checkedStepSequenceOf[T SignedIntegers]() error {
+	var iter *GeneratingIterator[T]
+	iter, t.sequenceErr = CheckedStepSequence(T(t.start), T(t.end), T(t.step))
+	if t.sequenceErr == nil {
+		t.resultingIntIterator = Map[T](iter, func(v T) int {
+			return int(v)
+		})
+	}
+	return nil
+}
+
+func checkedStepSequenceIsCalledFor(typ string) error {
+	switch typ {
+	case "int8":
+		return checkedStepSequenceOf[int8]()
+	case "int32":
+		return checkedStepSequenceOf[int32]()
+	case "int64":
+		return checkedStepSequenceOf[int64]()
+	}
+	return fmt.Errorf("unsupported type: %v", typ)
+}
+
+func checkedSequenceIsCalled() {
+	var iter *GeneratingIterator[int]
+	iter, t.sequenceErr = CheckedSequence(t.start, t.end)
+	if t.sequenceErr == nil {
+		t.resultingIntIterator = iter
+	}
+}
+
+func checkedUnsignedSequenceIsCalledWithAnd(start, end string) error {
+	s, err := strconv.ParseUint(start, 10, 64)
+	if err != nil {
+		return err
+	}
+	e, err := strconv.ParseUint(end, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, t.sequenceErr = CheckedUnsignedSequence(s, e)
+	return nil
+}
+
+func theSequenceReturnsTheError(name string) error {
+	expected := map[string]error{"ErrZeroStep": ErrZeroStep, "ErrSequenceOverflow": ErrSequenceOverflow}[name]
+	if t.sequenceErr != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, t.sequenceErr)
+	}
+	return nil
+}
+
+func stepSequenceWithAZeroStepPanicsWith(name string) (err error) {
+	defer func() {
+		if r := recover(); r != ErrZeroStep || name != "ErrZeroStep" {
+			err = fmt.Errorf("expected: %v got: %v", name, r)
+		}
+	}()
+	StepSequence(1, 2, 0)
+	return
+}
+
 func valuesStringToIntSlice(in string) (result []int, err error) {
 	for _, s := range strings.Split(in, ",") {
 		i, err2 := strconv.Atoi(s)
@@ -652,15 +780,16 @@ func errorOfIntIteratorReturnsNil() error {
 
 func aClosedChannelWithTheFollowingValues(listofints *godog.Table) {
 	t.channel = make(chan int)
+	c := t.channel
 	go func() {
 		values, err := toSliceOfInts(listofints)
 		if err != nil {
 			panic(err)
 		}
 		for _, v := range values {
-			t.channel <- v
+			c <- v
 		}
-		close(t.channel)
+		close(c)
 	}()
 }
 
@@ -736,6 +865,16 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Step(`^an step value of (-?\d+)$`, anStepValueOf)
 	ctx.Step(`^StepSequence is called$`, stepSequenceIsCalled)
 	ctx.Step(`^Sequence is called$`, sequenceIsCalled)
+	ctx.Step(`^CheckedStepSequence is called for (int8|int32|int64)$`, checkedStepSequenceIsCalledFor)
+	ctx.Step(`^CheckedSequence is called$`, checkedSequenceIsCalled)
+	ctx.Step(`^CheckedUnsignedSequence is called with (\d+) and (\d+)$`, checkedUnsignedSequenceIsCalledWithAnd)
+	ctx.Step(`^the sequence returns the error (ErrZeroStep|ErrSequenceOverflow)$`, theSequenceReturnsTheError)
+	ctx.Step(`^StepSequence with a zero step panics with (ErrZeroStep)$`, stepSequenceWithAZeroStepPanicsWith)
+	ctx.Step(`^UnsignedStepSequence is called$`, unsignedStepSequenceIsCalled)
+	ctx.Step(`^UnsignedSequence is called$`, unsignedSequenceIsCalled)
+	ctx.Step(`^Linspace is called with (-?[\d.]+), (-?[\d.]+) and (\d+)$`, linspaceIsCalledWithAnd)
+	ctx.Step(`^Arange is called with (-?[\d.]+), (-?[\d.]+) and (-?[\d.]+)$`, arangeIsCalledWithAnd)
+	ctx.Step(`^the following floats are returned: "([^"]*)"$`, theFollowingFloatsAreReturned)
 	ctx.Step(`^calling Next\(\) until false is returned should return the following values: "([^"]*)"$`, callingNextUntilFalseIsReturnedShouldReturnTheFollowingValues)
 	ctx.Step(`^an Iterable in an error state$`, anIterableInAnErrorState)
 	ctx.Step(`^Error\(\) of int iterator returns an error$`, errorOfIntIteratorReturnsAnError)
@@ -749,6 +888,28 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Step(`^ToChannel is called$`, toChannelIsCalled)
 	ctx.Step(`^a channel$`, aChannel)
 
+	initializeSeqScenario(ctx)
+	initializeContextScenario(ctx)
+	initializeFallibleScenario(ctx)
+	initializeParallelScenario(ctx)
+	initializeSlicingScenario(ctx)
+	initializeChunkScenario(ctx)
+	initializeZipScenario(ctx)
+	initializeConcatScenario(ctx)
+	initializeCollectScenario(ctx)
+	initializeStatsScenario(ctx)
+	initializeQueriesScenario(ctx)
+	initializePeekScenario(ctx)
+	initializeCloseScenario(ctx)
+	initializeSizeScenario(ctx)
+	initializeGeneratorsScenario(ctx)
+	initializePagesScenario(ctx)
+	initializeReaderScenario(ctx)
+	initializeJSONScenario(ctx)
+	initializeCSVScenario(ctx)
+	initializeSQLScenario(ctx)
+	initializeWalkScenario(ctx)
+	initializeArchiveScenario(ctx)
 }
 
 func TestFeatures(t *testing.T) {
@@ -0,0 +1,256 @@
+package iterator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSON
+
+// DecodeError is the error that is returned by the Error method of the JSON iterators when an element cannot be
+// decoded.
+type DecodeError struct {
+	// Index is the index of the element that failed, starting at 0.
+	Index int
+	// Offset is the byte offset in the input where the element starts, after the separator and whitespace.
+	Offset int64
+	// Err is the error of the json.Decoder.
+	Err error
+}
+
+// Error returns the error message with the index and offset of the element.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("iterator: decoding element %d at offset %d: %v", e.Index, e.Offset, e.Err)
+}
+
+// Unwrap returns the error of the json.Decoder.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// offsetReader is an io.Reader that keeps the bytes that are read since an input offset, so the start of a value
+// that failed to decode can be found after the json.Decoder has consumed it.
+type offsetReader struct {
+	// r contains the reader of the input
+	r io.Reader
+	// buf contains the bytes that are read since start
+	buf []byte
+	// start contains the input offset of the first byte in buf
+	start int64
+}
+
+// Read reads from the input and keeps the bytes that are read.
+func (o *offsetReader) Read(p []byte) (int, error) {
+	n, err := o.r.Read(p)
+	o.buf = append(o.buf, p[:n]...)
+	return n, err
+}
+
+// discard discards the bytes before the input offset. The bytes are only moved when at least half of the kept bytes
+// can be discarded, so keeping the bytes costs amortized constant time per byte.
+func (o *offsetReader) discard(offset int64) {
+	if n := int(offset - o.start); n > 0 && n >= len(o.buf)/2 {
+		o.buf = o.buf[:copy(o.buf, o.buf[min(n, len(o.buf)):])]
+		o.start = offset
+	}
+}
+
+// valueStart returns the input offset of the first byte after the offset that is not JSON whitespace or the first
+// occurrence of the separator. The separator is a comma in arrays, and 0 for values that are not separated.
+func (o *offsetReader) valueStart(offset int64, sep byte) int64 {
+	for ; offset-o.start < int64(len(o.buf)); offset++ {
+		switch c := o.buf[offset-o.start]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		case sep != 0 && c == sep:
+			sep = 0
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// decodeValue decodes the next value of the decoder into v. When decoding fails, the input offset where the value
+// starts is returned. The offset of the decoder points at the end of the previous value, so the start is found after
+// the separator and whitespace that precede the value, in the bytes kept by the offsetReader.
+func decodeValue(dec *json.Decoder, r *offsetReader, v any, sep byte) (int64, error) {
+	offset := dec.InputOffset()
+	r.discard(offset)
+	if err := dec.Decode(v); err != nil {
+		return r.valueStart(offset, sep), err
+	}
+	return offset, nil
+}
+
+// JSONArrayIterator is a struct the implements an Iterable that decodes the elements of a JSON array one by one.
+type JSONArrayIterator[T any] struct {
+	// dec contains the json.Decoder that reads the array
+	dec *json.Decoder
+	// r contains the reader of the decoder, that keeps the bytes of the current element
+	r *offsetReader
+	// idx contains the index of the next element
+	idx int
+	// started is true when the opening bracket of the array has been read
+	started bool
+	// done is true when the closing bracket of the array has been read or an error occurred
+	done bool
+	// err contains the error that occurred during decoding
+	err error
+}
+
+// Next returns the first or next element of the array and true if an element is available.
+// If no more elements are available or an error has occurred then a zero value of T and false is returned.
+func (iter *JSONArrayIterator[T]) Next() (T, bool) {
+	var t T
+	if iter.done {
+		return t, false
+	}
+	if !iter.started {
+		iter.started = true
+		if tok, err := iter.dec.Token(); err != nil || tok != json.Delim('[') {
+			if err == nil {
+				err = fmt.Errorf("expected the start of an array, got %v", tok)
+			}
+			return t, iter.fail(0, err)
+		}
+	}
+	offset := iter.dec.InputOffset()
+	if !iter.dec.More() {
+		if _, err := iter.dec.Token(); err != nil {
+			return t, iter.fail(offset, err)
+		}
+		iter.done = true
+		return t, false
+	}
+	if offset, err := decodeValue(iter.dec, iter.r, &t, ','); err != nil {
+		var zero T
+		return zero, iter.fail(offset, err)
+	}
+	iter.idx++
+	return t, true
+}
+
+// fail stops the iteration with a DecodeError for the current element and returns false.
+func (iter *JSONArrayIterator[T]) fail(offset int64, err error) bool {
+	iter.done = true
+	iter.err = &DecodeError{Index: iter.idx, Offset: offset, Err: err}
+	return false
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. Decoding errors are returned as *DecodeError.
+func (iter *JSONArrayIterator[T]) Error() error {
+	return iter.err
+}
+
+// SizeHint returns exactly zero when the iteration is done, otherwise nothing is known about the number of elements.
+func (iter *JSONArrayIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromJSONArray accepts an io.Reader with a JSON array and returns a JSONArrayIterator that decodes the elements of
+// the array one by one into values of T, so the array is never completely loaded in memory. When an element cannot
+// be decoded, or the input is not an array, the iteration stops and Error returns a *DecodeError. The reader is not
+// closed, use OnClose to close it when the iteration is done.
+func FromJSONArray[T any](r io.Reader) *JSONArrayIterator[T] {
+	o := &offsetReader{r: r}
+	return &JSONArrayIterator[T]{dec: json.NewDecoder(o), r: o}
+}
+
+// NDJSONIterator is a struct the implements an Iterable that decodes newline delimited JSON values one by one.
+type NDJSONIterator[T any] struct {
+	// dec contains the json.Decoder that reads the values
+	dec *json.Decoder
+	// r contains the reader of the decoder, that keeps the bytes of the current value
+	r *offsetReader
+	// idx contains the index of the next value
+	idx int
+	// done is true when the input is completely read or an error occurred
+	done bool
+	// err contains the error that occurred during decoding
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *NDJSONIterator[T]) Next() (T, bool) {
+	var t T
+	if iter.done {
+		return t, false
+	}
+	if offset, err := decodeValue(iter.dec, iter.r, &t, 0); err != nil {
+		iter.done = true
+		if err != io.EOF {
+			iter.err = &DecodeError{Index: iter.idx, Offset: offset, Err: err}
+		}
+		var zero T
+		return zero, false
+	}
+	iter.idx++
+	return t, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. Decoding errors are returned as *DecodeError.
+func (iter *NDJSONIterator[T]) Error() error {
+	return iter.err
+}
+
+// SizeHint returns exactly zero when the iteration is done, otherwise nothing is known about the number of values.
+func (iter *NDJSONIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromNDJSON accepts an io.Reader with newline delimited JSON and returns an NDJSONIterator that decodes the values
+// one by one into values of T. Empty lines are skipped. When a value cannot be decoded the iteration stops and Error
+// returns a *DecodeError. The reader is not closed, use OnClose to close it when the iteration is done.
+func FromNDJSON[T any](r io.Reader) *NDJSONIterator[T] {
+	o := &offsetReader{r: r}
+	return &NDJSONIterator[T]{dec: json.NewDecoder(o), r: o}
+}
+
+// ToNDJSON writes the values of the Iterable as newline delimited JSON to the io.Writer. When a value cannot be
+// encoded or written, the iteration stops and the error is returned. The Iterable is closed when the iteration is
+// done.
+func ToNDJSON[T any](iter Iterable[T], w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		if err := enc.Encode(v); err != nil {
+			return join(err, Close(iter))
+		}
+	}
+	return Finish(iter)
+}
+
+// ToJSONArray writes the values of the Iterable as a JSON array to the io.Writer, one value at a time. When a value
+// cannot be encoded or written, or the Iterable returns an error, the iteration stops and the error is returned
+// without terminating the array. The Iterable is closed when the iteration is done.
+func ToJSONArray[T any](iter Iterable[T], w io.Writer) error {
+	sep := "["
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		data, err := json.Marshal(v)
+		if err == nil {
+			_, err = w.Write(append([]byte(sep), data...))
+		}
+		if err != nil {
+			return join(err, Close(iter))
+		}
+		sep = ","
+	}
+	if err := Finish(iter); err != nil {
+		return err
+	}
+	end := "]"
+	if sep == "[" {
+		end = "[]"
+	}
+	_, err := io.WriteString(w, end)
+	return err
+}
@@ -0,0 +1,142 @@
+package iterator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromJSONArray() {
+	type user struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	input := strings.NewReader(`[{"name": "alice", "age": 31}, {"name": "bob", "age": 17}, {"name": "carol", "age": 45}]`)
+
+	adults := Filter[user](FromJSONArray[user](input), func(u user) bool {
+		return u.Age >= 18
+	})
+
+	err := ToNDJSON[user](adults, os.Stdout)
+	fmt.Println(err)
+
+	// Output:
+	// {"name":"alice","age":31}
+	// {"name":"carol","age":45}
+	// <nil>
+}
+
+func ExampleFromNDJSON() {
+	input := strings.NewReader("1\n2\nthree\n4\n")
+
+	values, err := ToSlice[int](FromNDJSON[int](input))
+	fmt.Println(values)
+	fmt.Println(err)
+
+	// Output:
+	// [1 2]
+	// iterator: decoding element 2 at offset 4: invalid character 'h' in literal true (expecting 'r')
+}
+
+// Tests
+
+type jsonFixture struct {
+	input  string
+	output bytes.Buffer
+}
+
+var js *jsonFixture
+
+func aJSONInput(input string) error {
+	s, err := strconv.Unquote(`"` + input + `"`)
+	js.input = s
+	return err
+}
+
+func aJSONArrayOfNumbersFollowedByAString(n int) {
+	var b strings.Builder
+	b.WriteString("[\n")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, "  %d,\n", i)
+	}
+	b.WriteString("  \"x\"\n]")
+	js.input = b.String()
+}
+
+func nDJSONOfNumbersFollowedByAString(n int) {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, "%d\n\n", i)
+	}
+	b.WriteString("\"x\"\n")
+	js.input = b.String()
+}
+
+func fromJSONArrayIsCalled() {
+	t.resultingIntIterator = FromJSONArray[int](strings.NewReader(js.input))
+}
+
+func fromNDJSONIsCalled() {
+	t.resultingIntIterator = FromNDJSON[int](strings.NewReader(js.input))
+}
+
+func toJSONArrayIsCalled() error {
+	return ToJSONArray(t.resultingIntIterator, &js.output)
+}
+
+func toNDJSONIsCalled() error {
+	return ToNDJSON(t.resultingIntIterator, &js.output)
+}
+
+func theOutputIsReadWithFromJSONArray() {
+	t.resultingIntIterator = FromJSONArray[int](&js.output)
+}
+
+func theOutputIs(expected string) error {
+	s, err := strconv.Unquote(`"` + expected + `"`)
+	if err != nil {
+		return err
+	}
+	if js.output.String() != s {
+		return fmt.Errorf("expected: %q got: %q", s, js.output.String())
+	}
+	return nil
+}
+
+func errorOfTheIntIteratorReturnsADecodeErrorAtIndexAndOffset(index int, offset int64) error {
+	var de *DecodeError
+	if err := t.resultingIntIterator.Error(); !errors.As(err, &de) {
+		return fmt.Errorf("expected a DecodeError got: %v", err)
+	}
+	if de.Index != index || de.Offset != offset {
+		return fmt.Errorf("expected: index %v and offset %v got: index %v and offset %v", index, offset, de.Index, de.Offset)
+	}
+	return nil
+}
+
+func errorOfTheIntIteratorReturnsADecodeErrorAtIndexAndTheOffsetOfTheString(index int) error {
+	return errorOfTheIntIteratorReturnsADecodeErrorAtIndexAndOffset(index, int64(strings.Index(js.input, `"x"`)))
+}
+
+func initializeJSONScenario(ctx *godog.ScenarioContext) {
+	js = &jsonFixture{}
+	ctx.Step(`^a JSON input "(.*)"$`, aJSONInput)
+	ctx.Step(`^a JSON array of (\d+) numbers followed by a string$`, aJSONArrayOfNumbersFollowedByAString)
+	ctx.Step(`^NDJSON of (\d+) numbers followed by a string$`, nDJSONOfNumbersFollowedByAString)
+	ctx.Step(`^FromJSONArray is called$`, fromJSONArrayIsCalled)
+	ctx.Step(`^FromNDJSON is called$`, fromNDJSONIsCalled)
+	ctx.Step(`^ToJSONArray is called$`, toJSONArrayIsCalled)
+	ctx.Step(`^ToNDJSON is called$`, toNDJSONIsCalled)
+	ctx.Step(`^the output is "([^"]*)"$`, theOutputIs)
+	ctx.Step(`^the output is read with FromJSONArray$`, theOutputIsReadWithFromJSONArray)
+	ctx.Step(`^Error\(\) of the int iterator returns a DecodeError at index (\d+) and offset (\d+)$`, errorOfTheIntIteratorReturnsADecodeErrorAtIndexAndOffset)
+	ctx.Step(`^Error\(\) of the int iterator returns a DecodeError at index (\d+) and the offset of the string$`, errorOfTheIntIteratorReturnsADecodeErrorAtIndexAndTheOffsetOfTheString)
+}
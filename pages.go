@@ -0,0 +1,205 @@
+package iterator
+
+import (
+	"context"
+	"time"
+)
+
+// Pages
+
+// FetchFunc is the closure type that is provided to FromPages. It receives the cursor of the page to fetch, which is
+// the zero value of C for the first page, and returns the items of that page and the cursor of the next page. The
+// zero value of C as next cursor means that there are no more pages.
+type FetchFunc[T any, C comparable] func(ctx context.Context, cursor C) (items []T, next C, err error)
+
+// BackoffFunc is the closure type that decides if a failed operation is retried. It receives the number of the
+// failed attempt, starting at 1, and the error. It returns the duration to wait before the next attempt and true,
+// or false when the operation must not be retried.
+type BackoffFunc func(attempt int, err error) (time.Duration, bool)
+
+// ExponentialBackoff returns a BackoffFunc that retries every error up to attempts times in total. The first retry
+// waits base, and each next retry waits twice as long, up to limit.
+func ExponentialBackoff(base time.Duration, limit time.Duration, attempts int) BackoffFunc {
+	return func(attempt int, _ error) (time.Duration, bool) {
+		if attempt >= attempts {
+			return 0, false
+		}
+		d := base
+		for i := 1; i < attempt && d < limit; i++ {
+			d *= 2
+		}
+		return min(d, limit), true
+	}
+}
+
+// retry calls f until it succeeds, the BackoffFunc closure decides not to retry, or the context is done. When
+// backoff is nil, f is called once. The last error of f, or the context error, is returned.
+func retry(ctx context.Context, backoff BackoffFunc, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := f()
+		if err == nil || backoff == nil {
+			return err
+		}
+		d, ok := backoff(attempt, err)
+		if !ok {
+			return err
+		}
+		timer := time.NewTimer(d)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// PageOptions contains the options of FromPages.
+type PageOptions struct {
+	// Prefetch fetches the next page in the background while the items of the current page are returned.
+	Prefetch bool
+	// Retry decides if a failed fetch is retried. When Retry is nil failed fetches are not retried.
+	Retry BackoffFunc
+}
+
+// page contains the result of fetching a page.
+type page[T any, C comparable] struct {
+	items []T
+	next  C
+	err   error
+}
+
+// PageIterator is a struct the implements an Iterable that returns the items of pages that are fetched with a
+// FetchFunc closure.
+type PageIterator[T any, C comparable] struct {
+	// ctx is passed to the FetchFunc closure, it is cancelled when the iterator is closed.
+	ctx context.Context
+	// cancel cancels ctx
+	cancel context.CancelFunc
+	// fetch contains the closure that fetches a page
+	fetch FetchFunc[T, C]
+	// opts contains the options
+	opts PageOptions
+	// items contains the items of the current page
+	items []T
+	// idx contains the position in items
+	idx int
+	// cursor contains the cursor of the next page
+	cursor C
+	// last is true when the current page is the last page
+	last bool
+	// pending receives the next page when it is prefetched
+	pending chan page[T, C]
+	// err contains the error that occurred during fetching
+	err error
+	// closed is true when Close has been called
+	closed bool
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *PageIterator[T, C]) Next() (T, bool) {
+	for !iter.closed {
+		if iter.idx < len(iter.items) {
+			v := iter.items[iter.idx]
+			iter.idx++
+			return v, true
+		}
+		if iter.last || iter.err != nil {
+			iter.cancel()
+			break
+		}
+		iter.load()
+	}
+	var t T
+	return t, false
+}
+
+// load replaces the current page with the next page, from the prefetched page when there is one.
+func (iter *PageIterator[T, C]) load() {
+	var p page[T, C]
+	if iter.pending != nil {
+		p = <-iter.pending
+		iter.pending = nil
+	} else {
+		p = iter.fetchPage(iter.cursor)
+	}
+	if p.err != nil {
+		iter.items, iter.idx, iter.err = nil, 0, p.err
+		return
+	}
+	var zero C
+	iter.items, iter.idx, iter.cursor, iter.last = p.items, 0, p.next, p.next == zero
+	if iter.opts.Prefetch && !iter.last {
+		iter.pending = make(chan page[T, C], 1)
+		go func(pending chan<- page[T, C], cursor C) {
+			pending <- iter.fetchPage(cursor)
+		}(iter.pending, p.next)
+	}
+}
+
+// fetchPage fetches the page at the cursor, and retries failed fetches when the Retry option allows it.
+func (iter *PageIterator[T, C]) fetchPage(cursor C) page[T, C] {
+	var p page[T, C]
+	err := retry(iter.ctx, iter.opts.Retry, func() (err error) {
+		p.items, p.next, err = iter.fetch(iter.ctx, cursor)
+		return
+	})
+	if err != nil {
+		return page[T, C]{err: err}
+	}
+	return p
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the last failed fetch is returned.
+func (iter *PageIterator[T, C]) Error() error {
+	return iter.err
+}
+
+// Close cancels the context that is passed to the FetchFunc closure and waits for a prefetch in the background to
+// finish. After Close, Next returns false.
+func (iter *PageIterator[T, C]) Close() error {
+	iter.closed = true
+	iter.cancel()
+	if iter.pending != nil {
+		<-iter.pending
+		iter.pending = nil
+	}
+	return nil
+}
+
+// SizeHint returns the number of items that remain in the current page as lower bound. The upper bound is only known
+// on the last page.
+func (iter *PageIterator[T, C]) SizeHint() (int, int, bool) {
+	if iter.closed || iter.err != nil {
+		return exactHint(0)
+	}
+	n := len(iter.items) - iter.idx
+	if iter.last {
+		return exactHint(n)
+	}
+	return n, -1, false
+}
+
+// FromPages accepts a FetchFunc closure and PageOptions and returns a PageIterator that returns the items of all
+// pages. Pages are fetched lazily, starting with the zero cursor, until the next cursor is the zero cursor. Empty
+// pages are skipped. When a fetch fails and is not retried, the iteration stops and Error returns the error.
+func FromPages[T any, C comparable](fetch FetchFunc[T, C], opts PageOptions) *PageIterator[T, C] {
+	return FromPagesContext(context.Background(), fetch, opts)
+}
+
+// FromPagesContext is FromPages with a context that is passed to the FetchFunc closure. When the context is done,
+// no more pages are fetched and Error returns the context error.
+func FromPagesContext[T any, C comparable](ctx context.Context, fetch FetchFunc[T, C], opts PageOptions) *PageIterator[T, C] {
+	ctx, cancel := context.WithCancel(ctx)
+	return &PageIterator[T, C]{
+		ctx:    ctx,
+		cancel: cancel,
+		fetch:  fetch,
+		opts:   opts,
+	}
+}
@@ -0,0 +1,160 @@
+package iterator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromPages() {
+	// A paginated backend that returns 3 values per page, the cursor is the offset of the page.
+	values := []string{"a", "b", "c", "d", "e", "f", "g"}
+	fetch := func(ctx context.Context, cursor int) ([]string, int, error) {
+		end := min(cursor+3, len(values))
+		next := end
+		if end == len(values) {
+			next = 0
+		}
+		return values[cursor:end], next, nil
+	}
+
+	items := FromPages(fetch, PageOptions{Prefetch: true, Retry: ExponentialBackoff(10*time.Millisecond, time.Second, 3)})
+
+	result, err := ToSlice[string](items)
+	fmt.Println(result, err)
+
+	// Output:
+	// [a b c d e f g] <nil>
+}
+
+// Tests
+
+// pageResponse is the JSON response of the paginated test server.
+type pageResponse struct {
+	Items []int  `json:"items"`
+	Next  string `json:"next"`
+}
+
+type pagesFixture struct {
+	server   *httptest.Server
+	values   []int
+	size     int
+	failures int32
+	status   int
+	requests atomic.Int32
+	opts     PageOptions
+}
+
+var pg *pagesFixture
+
+// handle serves the page at the offset in the cursor query parameter, or fails with the configured status code for
+// the configured number of requests.
+func (f *pagesFixture) handle(w http.ResponseWriter, r *http.Request) {
+	if f.requests.Add(1) <= f.failures {
+		w.WriteHeader(f.status)
+		return
+	}
+	offset := 0
+	if c := r.URL.Query().Get("cursor"); c != "" {
+		offset, _ = strconv.Atoi(c)
+	}
+	end := min(offset+f.size, len(f.values))
+	resp := pageResponse{Items: f.values[offset:end]}
+	if end < len(f.values) {
+		resp.Next = strconv.Itoa(end)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// fetch fetches a page from the paginated test server.
+func (f *pagesFixture) fetch(ctx context.Context, cursor string) ([]int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.server.URL+"?cursor="+cursor, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := f.server.Client().Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("unexpected status: %v", resp.StatusCode)
+	}
+	var page pageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, "", err
+	}
+	return page.Items, page.Next, nil
+}
+
+func aPaginatedServerWithValuesPerPageFrom(size, start, end int) {
+	pg.size = size
+	for v := start; v <= end; v++ {
+		pg.values = append(pg.values, v)
+	}
+	pg.server = httptest.NewServer(http.HandlerFunc(pg.handle))
+}
+
+func theServerFailsTheFirstRequestsWithStatus(n, status int) {
+	pg.failures, pg.status = int32(n), status
+}
+
+func failedFetchesAreRetriedTimes(n int) {
+	pg.opts.Retry = func(attempt int, _ error) (time.Duration, bool) {
+		return time.Millisecond, attempt <= n
+	}
+}
+
+func pagesArePrefetched() {
+	pg.opts.Prefetch = true
+}
+
+func fromPagesIsCalled() {
+	t.resultingIntIterator = FromPages(pg.fetch, pg.opts)
+}
+
+func theServerReceivedRequests(n int) error {
+	if r := pg.requests.Load(); r != int32(n) {
+		return fmt.Errorf("expected: %v got: %v", n, r)
+	}
+	return nil
+}
+
+func theServerEventuallyReceivedRequests(n int) error {
+	deadline := time.Now().Add(time.Second)
+	for pg.requests.Load() < int32(n) && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return theServerReceivedRequests(n)
+}
+
+func theIntIteratorIsClosed() error {
+	return Close(t.resultingIntIterator)
+}
+
+func initializePagesScenario(ctx *godog.ScenarioContext) {
+	pg = &pagesFixture{}
+	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
+		if pg.server != nil {
+			pg.server.Close()
+		}
+		return ctx, nil
+	})
+	ctx.Step(`^a paginated server with (\d+) values per page from (\d+) to (\d+)$`, aPaginatedServerWithValuesPerPageFrom)
+	ctx.Step(`^the server fails the first (\d+) requests with status (\d+)$`, theServerFailsTheFirstRequestsWithStatus)
+	ctx.Step(`^failed fetches are retried (\d+) times$`, failedFetchesAreRetriedTimes)
+	ctx.Step(`^pages are prefetched$`, pagesArePrefetched)
+	ctx.Step(`^FromPages is called$`, fromPagesIsCalled)
+	ctx.Step(`^the server received (\d+) requests$`, theServerReceivedRequests)
+	ctx.Step(`^the server eventually received (\d+) requests$`, theServerEventuallyReceivedRequests)
+	ctx.Step(`^the int iterator is closed$`, theIntIteratorIsClosed)
+}
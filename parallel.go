@@ -0,0 +1,245 @@
+package iterator
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelMap
+
+// parallelJob contains a value of the source Iterable and its position in the source.
+type parallelJob[T any] struct {
+	idx   int
+	value T
+}
+
+// parallelResult contains a transformed value, or the error of the MapErrFunc closure, and its position in the
+// source.
+type parallelResult[R any] struct {
+	idx   int
+	value R
+	err   error
+}
+
+// ParallelMapIterator is a struct the implements an Iterable that performs the map operation on multiple goroutines.
+type ParallelMapIterator[T any, R any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from. It is only accessed by the producer
+	// goroutine.
+	srcItr Iterable[T]
+	// mapFunc is the closure that performs the map operation.
+	mapFunc MapErrFunc[T, R]
+	// workers contains the number of goroutines that call mapFunc.
+	workers int
+	// ordered contains true when the values are returned in the order of the source.
+	ordered bool
+	// ctx is cancelled when the iteration stops, it is derived from the context provided by the caller.
+	ctx context.Context
+	// cancel cancels ctx.
+	cancel context.CancelFunc
+	// window is a semaphore that bounds the number of values that are pulled from the source but not yet returned.
+	window chan struct{}
+	// results receives the results of the workers, and is closed when all workers are done.
+	results chan parallelResult[R]
+	// pending contains the results that arrived before their turn when ordered is true.
+	pending map[int]parallelResult[R]
+	// idx contains the position of the next value to return when ordered is true.
+	idx int
+	// wg tracks all goroutines started by the iterator.
+	wg sync.WaitGroup
+	// srcErr contains the error of the source, it is written by the producer before results is closed.
+	srcErr error
+	// err contains the error that stopped the iteration.
+	err error
+	// started contains true when the goroutines are started.
+	started bool
+	// done contains true when the iteration has stopped.
+	done bool
+}
+
+// start starts the producer goroutine that pulls values from the source, the worker goroutines that call mapFunc
+// and a goroutine that closes results when all workers are done.
+func (iter *ParallelMapIterator[T, R]) start() {
+	iter.started = true
+	jobs := make(chan parallelJob[T])
+	iter.wg.Add(1)
+	go func() {
+		defer iter.wg.Done()
+		defer close(jobs)
+		for idx := 0; ; idx++ {
+			select {
+			case iter.window <- struct{}{}:
+			case <-iter.ctx.Done():
+				return
+			}
+			v, b := iter.srcItr.Next()
+			if !b {
+				iter.srcErr = iter.srcItr.Error()
+				return
+			}
+			select {
+			case jobs <- parallelJob[T]{idx: idx, value: v}:
+			case <-iter.ctx.Done():
+				return
+			}
+		}
+	}()
+	var workers sync.WaitGroup
+	workers.Add(iter.workers)
+	iter.wg.Add(iter.workers + 1)
+	for n := 0; n < iter.workers; n++ {
+		go func() {
+			defer iter.wg.Done()
+			defer workers.Done()
+			for job := range jobs {
+				r, err := iter.mapFunc(job.value)
+				select {
+				case iter.results <- parallelResult[R]{idx: job.idx, value: r, err: err}:
+				case <-iter.ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer iter.wg.Done()
+		workers.Wait()
+		close(iter.results)
+	}()
+}
+
+// emit releases the window slot of the result and returns its value, or stops the iteration when the
+// MapErrFunc closure returned an error.
+func (iter *ParallelMapIterator[T, R]) emit(r parallelResult[R]) (R, bool) {
+	<-iter.window
+	if r.err != nil {
+		iter.err = r.err
+		iter.Stop()
+		var zero R
+		return zero, false
+	}
+	return r.value, true
+}
+
+// Next returns the first or next value of R and true if a value is available.
+// Each value is transformed with the provided MapErrFunc closure on one of the worker goroutines.
+// If no more values are available or an error has occurred then a zero value of R and false is returned.
+func (iter *ParallelMapIterator[T, R]) Next() (R, bool) {
+	var zero R
+	if iter.done {
+		return zero, false
+	}
+	if !iter.started {
+		iter.start()
+	}
+	if err := iter.ctx.Err(); err != nil {
+		iter.err = err
+		iter.Stop()
+		return zero, false
+	}
+	for {
+		if r, ok := iter.pending[iter.idx]; ok {
+			delete(iter.pending, iter.idx)
+			iter.idx++
+			return iter.emit(r)
+		}
+		select {
+		case r, ok := <-iter.results:
+			if !ok {
+				iter.err = iter.srcErr
+				iter.Stop()
+				return zero, false
+			}
+			if !iter.ordered || r.idx == iter.idx {
+				iter.idx++
+				return iter.emit(r)
+			}
+			iter.pending[r.idx] = r
+		case <-iter.ctx.Done():
+			iter.err = iter.ctx.Err()
+			iter.Stop()
+			return zero, false
+		}
+	}
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the source Iterable, the first error of the MapErrFunc closure or the error of
+// the context is returned.
+func (iter *ParallelMapIterator[T, R]) Error() error {
+	return iter.err
+}
+
+// Close stops the iteration like Stop does and closes the source Iterable when it implements io.Closer.
+func (iter *ParallelMapIterator[T, R]) Close() error {
+	iter.Stop()
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the upper bound of the source Iterable before the iteration has started, because an error can
+// stop the iteration early. Once started, nothing is known about the number of values.
+func (iter *ParallelMapIterator[T, R]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	if !iter.started {
+		return upperHint(iter.srcItr)
+	}
+	return 0, -1, false
+}
+
+// Stop ends the iteration and waits until all goroutines started by the iterator have returned. Stop must be called
+// when the iteration is abandoned before Next returned false. Calling Stop more than once is allowed.
+// Stop also waits for a Next call on the source Iterable that is in progress, use a source that is context-aware,
+// like FromChannelContext, when that call can block.
+func (iter *ParallelMapIterator[T, R]) Stop() {
+	if iter.done {
+		return
+	}
+	iter.done = true
+	iter.cancel()
+	iter.wg.Wait()
+}
+
+// newParallelMap creates a ParallelMapIterator, the goroutines are started on the first call to Next.
+func newParallelMap[T any, R any](ctx context.Context, iter Iterable[T], workers int, ordered bool, f MapErrFunc[T, R]) *ParallelMapIterator[T, R] {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &ParallelMapIterator[T, R]{
+		srcItr:  iter,
+		mapFunc: f,
+		workers: workers,
+		ordered: ordered,
+		ctx:     ctx,
+		cancel:  cancel,
+		window:  make(chan struct{}, 2*workers),
+		results: make(chan parallelResult[R]),
+		pending: make(map[int]parallelResult[R]),
+	}
+}
+
+// ParallelMap accepts an Iterable, the number of workers and a MapErrFunc closure and creates a ParallelMapIterator
+// that performs the map operation on the provided number of goroutines. The values are returned in the order of the
+// provided Iterable. At most twice the number of workers values are in flight at any time.
+func ParallelMap[T any, R any](iter Iterable[T], workers int, f MapErrFunc[T, R]) *ParallelMapIterator[T, R] {
+	return newParallelMap(context.Background(), iter, workers, true, f)
+}
+
+// ParallelMapUnordered is like ParallelMap, but returns the values as soon as they are transformed instead of in the
+// order of the provided Iterable.
+func ParallelMapUnordered[T any, R any](iter Iterable[T], workers int, f MapErrFunc[T, R]) *ParallelMapIterator[T, R] {
+	return newParallelMap(context.Background(), iter, workers, false, f)
+}
+
+// ParallelMapContext is like ParallelMap, but stops the iteration and all goroutines when the provided context is
+// done.
+func ParallelMapContext[T any, R any](ctx context.Context, iter Iterable[T], workers int, f MapErrFunc[T, R]) *ParallelMapIterator[T, R] {
+	return newParallelMap(ctx, iter, workers, true, f)
+}
+
+// ParallelMapUnorderedContext is like ParallelMapUnordered, but stops the iteration and all goroutines when the
+// provided context is done.
+func ParallelMapUnorderedContext[T any, R any](ctx context.Context, iter Iterable[T], workers int, f MapErrFunc[T, R]) *ParallelMapIterator[T, R] {
+	return newParallelMap(ctx, iter, workers, false, f)
+}
@@ -0,0 +1,188 @@
+package iterator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleParallelMap() {
+	// square is a map closure that squares each value, it could be an expensive calculation or a remote call.
+	square := func(v int) (int, error) {
+		return v * v, nil
+	}
+
+	// Get a parallel map iterator that squares the values of the sequence on 4 goroutines.
+	pi := ParallelMap[int, int](Sequence(1, 5), 4, square)
+	// Stop waits for the goroutines, it is required when the iteration could be abandoned early.
+	defer pi.Stop()
+
+	_ = ForEach[int](pi, func(v int) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// 1
+	// 4
+	// 9
+	// 16
+	// 25
+}
+
+// Tests
+
+type parallelFixture struct {
+	parallel   *ParallelMapIterator[int, string]
+	goroutines int
+	running    atomic.Int32
+	maxRunning atomic.Int32
+	pulled     atomic.Int32
+}
+
+var px *parallelFixture
+
+// countingIterator counts the values pulled from the source.
+type countingIterator struct {
+	Iterable[int]
+}
+
+func (c countingIterator) Next() (int, bool) {
+	px.pulled.Add(1)
+	return c.Iterable.Next()
+}
+
+func slowMapFunction(v int) (string, error) {
+	running := px.running.Add(1)
+	defer px.running.Add(-1)
+	for m := px.maxRunning.Load(); running > m && !px.maxRunning.CompareAndSwap(m, running); m = px.maxRunning.Load() {
+	}
+	time.Sleep(time.Duration(10-v%10) * 100 * time.Microsecond)
+	return strconv.Itoa(v), nil
+}
+
+func parallelMapIsCalledWithWorkersAndASlowMapFunctionThatConvertsTheIntToAString(workers int) {
+	px.goroutines = runtime.NumGoroutine()
+	px.parallel = ParallelMap[int, string](countingIterator{t.resultingIntIterator}, workers, slowMapFunction)
+	t.resultingStringIterator = px.parallel
+}
+
+func parallelMapUnorderedIsCalledWithWorkersAndASlowMapFunctionThatConvertsTheIntToAString(workers int) {
+	px.goroutines = runtime.NumGoroutine()
+	px.parallel = ParallelMapUnordered[int, string](countingIterator{t.resultingIntIterator}, workers, slowMapFunction)
+	t.resultingStringIterator = px.parallel
+}
+
+func parallelMapContextIsCalledWithWorkersAndASlowMapFunctionThatConvertsTheIntToAString(workers int) {
+	px.goroutines = runtime.NumGoroutine()
+	px.parallel = ParallelMapContext[int, string](cx.ctx, countingIterator{t.resultingIntIterator}, workers, slowMapFunction)
+	t.resultingStringIterator = px.parallel
+}
+
+func parallelMapIsCalledWithWorkersAndAMapFunctionThatFailsOn(workers, n int) {
+	px.goroutines = runtime.NumGoroutine()
+	px.parallel = ParallelMap[int, string](t.resultingIntIterator, workers, func(v int) (string, error) {
+		if v == n {
+			return "", failure(v)
+		}
+		return slowMapFunction(v)
+	})
+	t.resultingStringIterator = px.parallel
+}
+
+func theSortedValuesOfTheStringIteratorAre(values string) error {
+	results, err := ToSlice(t.resultingStringIterator)
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(results, func(a, b string) int {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x - y
+	})
+	if values != strings.Join(results, ",") {
+		return fmt.Errorf("expected: %v got: %v", values, results)
+	}
+	return nil
+}
+
+func atMostMapCallsRanAtTheSameTime(n int) error {
+	if m := px.maxRunning.Load(); m > int32(n) || m == 0 {
+		return fmt.Errorf("expected at most %d map calls at the same time, got: %d", n, m)
+	}
+	return nil
+}
+
+func noGoroutinesOfTheParallelMapAreRunning() error {
+	// Goroutines that returned can take a moment to be removed from the count.
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= px.goroutines {
+			return nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return fmt.Errorf("expected: %d goroutines got: %d", px.goroutines, runtime.NumGoroutine())
+}
+
+func errorOfStringIteratorReturnsNilUntilNextIsCalled() error {
+	if err := t.resultingStringIterator.Error(); err != nil {
+		return fmt.Errorf("expected nil but got: %v", err)
+	}
+	if _, b := t.resultingStringIterator.Next(); b {
+		return errors.New("expected: false got: true")
+	}
+	return nil
+}
+
+func errorOfStringIteratorReturnsTheContextError() error {
+	if err := t.resultingStringIterator.Error(); !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("expected: %v got: %v", context.Canceled, err)
+	}
+	return nil
+}
+
+func nextOfTheParallelMapIsCalledTimes(n int) error {
+	for ; n > 0; n-- {
+		if _, b := px.parallel.Next(); !b {
+			return errors.New("expected: true got: false")
+		}
+	}
+	return nil
+}
+
+func stopOfTheParallelMapIsCalled() {
+	px.parallel.Stop()
+}
+
+func lessThanValuesArePulledFromTheSource(n int) error {
+	if p := px.pulled.Load(); p >= int32(n) {
+		return fmt.Errorf("expected less than %d values pulled got: %d", n, p)
+	}
+	return nil
+}
+
+func initializeParallelScenario(ctx *godog.ScenarioContext) {
+	px = &parallelFixture{}
+
+	ctx.Step(`^ParallelMap is called with (\d+) workers and a slow map function that converts the int to a string$`, parallelMapIsCalledWithWorkersAndASlowMapFunctionThatConvertsTheIntToAString)
+	ctx.Step(`^ParallelMapUnordered is called with (\d+) workers and a slow map function that converts the int to a string$`, parallelMapUnorderedIsCalledWithWorkersAndASlowMapFunctionThatConvertsTheIntToAString)
+	ctx.Step(`^ParallelMapContext is called with (\d+) workers and a slow map function that converts the int to a string$`, parallelMapContextIsCalledWithWorkersAndASlowMapFunctionThatConvertsTheIntToAString)
+	ctx.Step(`^ParallelMap is called with (\d+) workers and a map function that fails on (\d+)$`, parallelMapIsCalledWithWorkersAndAMapFunctionThatFailsOn)
+	ctx.Step(`^the sorted values of the string iterator are: "([^"]*)"$`, theSortedValuesOfTheStringIteratorAre)
+	ctx.Step(`^at most (\d+) map calls ran at the same time$`, atMostMapCallsRanAtTheSameTime)
+	ctx.Step(`^no goroutines of the parallel map are running$`, noGoroutinesOfTheParallelMapAreRunning)
+	ctx.Step(`^Error\(\) of string iterator returns nil until Next\(\) is called$`, errorOfStringIteratorReturnsNilUntilNextIsCalled)
+	ctx.Step(`^Error\(\) of string iterator returns the context error$`, errorOfStringIteratorReturnsTheContextError)
+	ctx.Step(`^Next\(\) of the parallel map is called (\d+) times$`, nextOfTheParallelMapIsCalledTimes)
+	ctx.Step(`^Stop of the parallel map is called$`, stopOfTheParallelMapIsCalled)
+	ctx.Step(`^less than (\d+) values are pulled from the source$`, lessThanValuesArePulledFromTheSource)
+}
@@ -0,0 +1,82 @@
+package iterator
+
+// Peekable
+
+// PeekableIterator is a struct the implements an Iterable that allows looking ahead at the next value and pushing
+// values back.
+type PeekableIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// pushed contains the peeked and pushed back values, the last value is returned first.
+	pushed []T
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// Pushed back and peeked values are returned before the next value of the source Iterable is pulled.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *PeekableIterator[T]) Next() (T, bool) {
+	if n := len(iter.pushed); n > 0 {
+		var zero T
+		v := iter.pushed[n-1]
+		iter.pushed[n-1] = zero
+		iter.pushed = iter.pushed[:n-1]
+		return v, true
+	}
+	return iter.srcItr.Next()
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. When Peek returned false, Error returns the error of the source Iterable.
+func (iter *PeekableIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *PeekableIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the size hint of the source Iterable plus the number of peeked and pushed back values.
+func (iter *PeekableIterator[T]) SizeHint() (int, int, bool) {
+	lower, upper, _ := SizeHint(iter.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		return addHint(n, len(iter.pushed))
+	})
+}
+
+// Peek returns the value that the next call to Next returns and true, without advancing the iteration.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *PeekableIterator[T]) Peek() (T, bool) {
+	if n := len(iter.pushed); n > 0 {
+		return iter.pushed[n-1], true
+	}
+	v, b := iter.srcItr.Next()
+	if b {
+		iter.pushed = append(iter.pushed, v)
+	}
+	return v, b
+}
+
+// NextIf returns the next value and true when the predicate returns true for that value. Otherwise the value is
+// kept, so the next call to Next or Peek returns it, and a zero value of T and false is returned.
+func (iter *PeekableIterator[T]) NextIf(predicate PredicateFunc[T]) (T, bool) {
+	if v, b := iter.Peek(); b && predicate(v) {
+		return iter.Next()
+	}
+	var t T
+	return t, false
+}
+
+// PushBack pushes a value back, so the next call to Next or Peek returns it. Any number of values can be pushed
+// back, the last value pushed back is returned first.
+func (iter *PeekableIterator[T]) PushBack(v T) {
+	iter.pushed = append(iter.pushed, v)
+}
+
+// Peekable accepts an Iterable and creates a PeekableIterator that returns the values of the provided Iterable and
+// allows looking ahead at the next value and pushing values back.
+func Peekable[T any](iter Iterable[T]) *PeekableIterator[T] {
+	return &PeekableIterator[T]{
+		srcItr: iter,
+	}
+}
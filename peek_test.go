@@ -0,0 +1,88 @@
+package iterator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExamplePeekable() {
+	lines := Peekable[string](FromSlice([]string{"# a", "1", "2", "# b", "3"}))
+
+	isHeader := func(s string) bool {
+		return strings.HasPrefix(s, "#")
+	}
+	isNotHeader := func(s string) bool {
+		return !isHeader(s)
+	}
+
+	// Group the lines after each header until the next header.
+	for header, b := lines.NextIf(isHeader); b; header, b = lines.NextIf(isHeader) {
+		var group []string
+		for line, b := lines.NextIf(isNotHeader); b; line, b = lines.NextIf(isNotHeader) {
+			group = append(group, line)
+		}
+		fmt.Println(header, group)
+	}
+
+	// Output:
+	// # a [1 2]
+	// # b [3]
+}
+
+// Tests
+
+var pk *PeekableIterator[int]
+
+func peekableIsCalled() {
+	pk = Peekable(t.resultingIntIterator)
+	t.resultingIntIterator = pk
+}
+
+func peekReturns(expected int) error {
+	v, b := pk.Peek()
+	if !b || v != expected {
+		return fmt.Errorf("expected: %v got: %v (%v)", expected, v, b)
+	}
+	return nil
+}
+
+func peekReturnsNothing() error {
+	if v, b := pk.Peek(); b {
+		return fmt.Errorf("expected nothing got: %v", v)
+	}
+	return nil
+}
+
+func nextIfWithAPredicateThatSelectsValuesLessThanReturns(n, expected int) error {
+	v, b := pk.NextIf(lessThan(n))
+	if !b || v != expected {
+		return fmt.Errorf("expected: %v got: %v (%v)", expected, v, b)
+	}
+	return nil
+}
+
+func nextIfWithAPredicateThatSelectsValuesLessThanReturnsNothing(n int) error {
+	if v, b := pk.NextIf(lessThan(n)); b {
+		return fmt.Errorf("expected nothing got: %v", v)
+	}
+	return nil
+}
+
+func isPushedBack(v int) {
+	pk.PushBack(v)
+}
+
+func initializePeekScenario(ctx *godog.ScenarioContext) {
+	pk = nil
+
+	ctx.Step(`^Peekable is called$`, peekableIsCalled)
+	ctx.Step(`^Peek returns (-?\d+)$`, peekReturns)
+	ctx.Step(`^Peek returns nothing$`, peekReturnsNothing)
+	ctx.Step(`^NextIf with a predicate that selects values less than (-?\d+) returns (-?\d+)$`, nextIfWithAPredicateThatSelectsValuesLessThanReturns)
+	ctx.Step(`^NextIf with a predicate that selects values less than (-?\d+) returns nothing$`, nextIfWithAPredicateThatSelectsValuesLessThanReturnsNothing)
+	ctx.Step(`^(-?\d+) is pushed back$`, isPushedBack)
+}
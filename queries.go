@@ -0,0 +1,96 @@
+package iterator
+
+// Queries
+// All queries close the Iterable when the answer is known.
+
+// Find accepts an Iterable and PredicateFunc closure and returns the first value for which the predicate returns
+// true and true. No more values are pulled from the Iterable after the value is found. When no value is found, a
+// zero value of T, false and the error that occurred during iteration are returned.
+func Find[T any](iter Iterable[T], predicate PredicateFunc[T]) (T, bool, error) {
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		if predicate(v) {
+			return v, true, Close(iter)
+		}
+	}
+	var t T
+	return t, false, Finish(iter)
+}
+
+// FindIndex accepts an Iterable and PredicateFunc closure and returns the zero based position of the first value
+// for which the predicate returns true and true. No more values are pulled from the Iterable after the value is
+// found. When no value is found, -1, false and the error that occurred during iteration are returned.
+func FindIndex[T any](iter Iterable[T], predicate PredicateFunc[T]) (int, bool, error) {
+	idx := 0
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		if predicate(v) {
+			return idx, true, Close(iter)
+		}
+		idx++
+	}
+	return -1, false, Finish(iter)
+}
+
+// Any accepts an Iterable and PredicateFunc closure and returns true when the predicate returns true for any value.
+// No more values are pulled from the Iterable after the predicate returned true.
+func Any[T any](iter Iterable[T], predicate PredicateFunc[T]) (bool, error) {
+	_, found, err := Find(iter, predicate)
+	return found, err
+}
+
+// All accepts an Iterable and PredicateFunc closure and returns true when the predicate returns true for all values.
+// No more values are pulled from the Iterable after the predicate returned false. True is returned when the
+// Iterable has no values.
+func All[T any](iter Iterable[T], predicate PredicateFunc[T]) (bool, error) {
+	found, err := Any(iter, func(v T) bool {
+		return !predicate(v)
+	})
+	return !found, err
+}
+
+// None accepts an Iterable and PredicateFunc closure and returns true when the predicate returns false for all
+// values. No more values are pulled from the Iterable after the predicate returned true. True is returned when the
+// Iterable has no values.
+func None[T any](iter Iterable[T], predicate PredicateFunc[T]) (bool, error) {
+	found, err := Any(iter, predicate)
+	return !found, err
+}
+
+// First accepts an Iterable and returns its first value and true. No more values are pulled from the Iterable.
+// When the Iterable has no values, a zero value of T, false and the error that occurred during iteration are
+// returned.
+func First[T any](iter Iterable[T]) (T, bool, error) {
+	return Nth(iter, 0)
+}
+
+// Last accepts an Iterable and returns its last value and true. When the Iterable has no values, a zero value of T
+// and false is returned.
+func Last[T any](iter Iterable[T]) (T, bool, error) {
+	var last T
+	found := false
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		last, found = v, true
+	}
+	return last, found, Finish(iter)
+}
+
+// Nth accepts an Iterable and a zero based position and returns the value at that position and true. No more values
+// are pulled from the Iterable after that value. When the Iterable has no value at that position, a zero value of T,
+// false and the error that occurred during iteration are returned.
+func Nth[T any](iter Iterable[T], n int) (T, bool, error) {
+	var t T
+	if n < 0 {
+		return t, false, Close(iter)
+	}
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		if n == 0 {
+			return v, true, Close(iter)
+		}
+		n--
+	}
+	return t, false, Finish(iter)
+}
+
+// Count accepts an Iterable and returns the number of values.
+func Count[T any](iter Iterable[T]) (int, error) {
+	return Collect(iter, Counting[T]())
+}
@@ -0,0 +1,139 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFind() {
+	// An endless sequence of squares, Find stops as soon as a value is found.
+	squares := Map[int, int](RepeatingIntegerGenerator(1, ^uint64(0), 1), func(v int) int {
+		return v * v
+	})
+
+	v, found, _ := Find[int](squares, func(v int) bool {
+		return v > 50
+	})
+
+	fmt.Println(v, found)
+
+	// Output:
+	// 64 true
+}
+
+// Tests
+
+type queryFixture struct {
+	result int
+	found  bool
+	answer bool
+	err    error
+}
+
+var qy queryFixture
+
+func anEndlessSequenceStartingAt(start int) {
+	t.resultingIntIterator = RepeatingIntegerGenerator(start, ^uint64(0), 1)
+}
+
+func greaterThan(n int) PredicateFunc[int] {
+	return func(v int) bool {
+		return v > n
+	}
+}
+
+func findIsCalledWithAPredicateThatSelectsValuesGreaterThan(n int) {
+	qy.result, qy.found, qy.err = Find(t.resultingIntIterator, greaterThan(n))
+}
+
+func findIndexIsCalledWithAPredicateThatSelectsValuesGreaterThan(n int) {
+	qy.result, qy.found, qy.err = FindIndex(t.resultingIntIterator, greaterThan(n))
+}
+
+func isCalledWithAPredicateThatSelectsValuesGreaterThan(query string, n int) error {
+	switch query {
+	case "Any":
+		qy.answer, qy.err = Any(t.resultingIntIterator, greaterThan(n))
+	case "All":
+		qy.answer, qy.err = All(t.resultingIntIterator, greaterThan(n))
+	case "None":
+		qy.answer, qy.err = None(t.resultingIntIterator, greaterThan(n))
+	default:
+		return fmt.Errorf("unknown query: %s", query)
+	}
+	return nil
+}
+
+func firstIsCalled() {
+	qy.result, qy.found, qy.err = First(t.resultingIntIterator)
+}
+
+func lastIsCalled() {
+	qy.result, qy.found, qy.err = Last(t.resultingIntIterator)
+}
+
+func nthIsCalledWith(n int) {
+	qy.result, qy.found, qy.err = Nth(t.resultingIntIterator, n)
+}
+
+func countIsCalled() {
+	qy.result, qy.err = Count(t.resultingIntIterator)
+	qy.found = true
+}
+
+func theQueryResultIs(expected int) error {
+	if qy.result != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, qy.result)
+	}
+	return nil
+}
+
+func theQueryFoundNothingWithoutAnError() error {
+	if qy.found || qy.err != nil {
+		return fmt.Errorf("expected nothing found without an error, got found: %v error: %v", qy.found, qy.err)
+	}
+	return nil
+}
+
+func theQueryFoundNothingAndReturnedAnError() error {
+	if qy.found || qy.err == nil {
+		return fmt.Errorf("expected nothing found with an error, got found: %v error: %v", qy.found, qy.err)
+	}
+	return nil
+}
+
+func theQueryAnswerIs(expected string) error {
+	if fmt.Sprint(qy.answer) != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, qy.answer)
+	}
+	return nil
+}
+
+func theQueryReturnedAnError() error {
+	if qy.err == nil {
+		return errors.New("expected an error but got nil")
+	}
+	return nil
+}
+
+func initializeQueriesScenario(ctx *godog.ScenarioContext) {
+	qy = queryFixture{}
+
+	ctx.Step(`^an endless sequence starting at (-?\d+)$`, anEndlessSequenceStartingAt)
+	ctx.Step(`^Find is called with a predicate that selects values greater than (-?\d+)$`, findIsCalledWithAPredicateThatSelectsValuesGreaterThan)
+	ctx.Step(`^FindIndex is called with a predicate that selects values greater than (-?\d+)$`, findIndexIsCalledWithAPredicateThatSelectsValuesGreaterThan)
+	ctx.Step(`^(Any|All|None) is called with a predicate that selects values greater than (-?\d+)$`, isCalledWithAPredicateThatSelectsValuesGreaterThan)
+	ctx.Step(`^First is called$`, firstIsCalled)
+	ctx.Step(`^Last is called$`, lastIsCalled)
+	ctx.Step(`^Nth is called with (\d+)$`, nthIsCalledWith)
+	ctx.Step(`^Count is called$`, countIsCalled)
+	ctx.Step(`^the query result is (-?\d+)$`, theQueryResultIs)
+	ctx.Step(`^the query found nothing without an error$`, theQueryFoundNothingWithoutAnError)
+	ctx.Step(`^the query found nothing and returned an error$`, theQueryFoundNothingAndReturnedAnError)
+	ctx.Step(`^the query answer is (true|false)$`, theQueryAnswerIs)
+	ctx.Step(`^the query returned an error$`, theQueryReturnedAnError)
+}
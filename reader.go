@@ -0,0 +1,129 @@
+package iterator
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// Readers
+
+// ReaderOptions contains the options of FromLines and FromDelimited.
+type ReaderOptions struct {
+	// MaxTokenSize is the maximum size of a line or record in bytes, without its line ending or delimiter. When a
+	// line or record is larger, the iteration stops and Error returns bufio.ErrTooLong. When MaxTokenSize is 0,
+	// bufio.MaxScanTokenSize is used.
+	MaxTokenSize int
+	// KeepLineEndings keeps the line ending or delimiter at the end of each line or record. Otherwise they are trimmed.
+	KeepLineEndings bool
+}
+
+// ScannerIterator is a struct the implements an Iterable that returns the tokens of a bufio.Scanner.
+type ScannerIterator struct {
+	// scanner contains the bufio.Scanner the tokens are read from
+	scanner *bufio.Scanner
+	// done is true when the scanner has stopped
+	done bool
+}
+
+// Next returns the first or next token and true if a token is available.
+// If no more tokens are available or an error has occurred then an empty string and false is returned.
+func (iter *ScannerIterator) Next() (string, bool) {
+	if iter.done || !iter.scanner.Scan() {
+		iter.done = true
+		return "", false
+	}
+	return iter.scanner.Text(), true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the bufio.Scanner is returned, for example bufio.ErrTooLong or the error
+// of the underlying io.Reader.
+func (iter *ScannerIterator) Error() error {
+	return iter.scanner.Err()
+}
+
+// SizeHint returns exactly zero when the scanner has stopped, otherwise nothing is known about the number of tokens.
+func (iter *ScannerIterator) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromScanner accepts a bufio.Scanner and returns a ScannerIterator that returns its tokens. The split function and
+// buffer of the scanner must be configured before the iteration starts.
+func FromScanner(s *bufio.Scanner) *ScannerIterator {
+	return &ScannerIterator{scanner: s}
+}
+
+// newScanner returns a bufio.Scanner for the io.Reader with the split function and the maximum token size of the
+// ReaderOptions. The buffer has room for a "\r\n" line ending after a token of the maximum size, and tokens that are
+// larger without their delimiter are rejected by the split function.
+func newScanner(r io.Reader, split bufio.SplitFunc, delim byte, opts ReaderOptions) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	if opts.MaxTokenSize > 0 {
+		s.Buffer(make([]byte, 0, min(opts.MaxTokenSize+2, 4096)), opts.MaxTokenSize+2)
+		split = limitTokens(split, opts.MaxTokenSize, delim, opts.KeepLineEndings)
+	}
+	s.Split(split)
+	return s
+}
+
+// limitTokens returns a bufio.SplitFunc that returns bufio.ErrTooLong for tokens of the split function that are
+// larger than max bytes without their delimiter, which is only part of the token when keep is true.
+func limitTokens(split bufio.SplitFunc, max int, delim byte, keep bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := split(data, atEOF)
+		size := len(token)
+		if keep && bytes.HasSuffix(token, []byte{delim}) {
+			size--
+			if delim == '\n' && bytes.HasSuffix(token, []byte("\r\n")) {
+				size--
+			}
+		}
+		if size > max {
+			return 0, nil, bufio.ErrTooLong
+		}
+		return advance, token, err
+	}
+}
+
+// FromLines accepts an io.Reader and ReaderOptions and returns a ScannerIterator that returns the lines of the
+// reader. Lines end with "\n" or "\r\n", and the last line may have no line ending. Unless KeepLineEndings is set,
+// the line endings are trimmed. The reader is not closed, use OnClose to close it when the iteration is done.
+func FromLines(r io.Reader, opts ReaderOptions) *ScannerIterator {
+	split := bufio.ScanLines
+	if opts.KeepLineEndings {
+		split = scanDelimited('\n', true)
+	}
+	return FromScanner(newScanner(r, split, '\n', opts))
+}
+
+// FromDelimited accepts an io.Reader, a delimiter and ReaderOptions and returns a ScannerIterator that returns the
+// records of the reader that are separated by the delimiter. The last record may have no delimiter. Unless
+// KeepLineEndings is set, the delimiters are trimmed. The reader is not closed, use OnClose to close it when the
+// iteration is done.
+func FromDelimited(r io.Reader, delim byte, opts ReaderOptions) *ScannerIterator {
+	return FromScanner(newScanner(r, scanDelimited(delim, opts.KeepLineEndings), delim, opts))
+}
+
+// scanDelimited returns a bufio.SplitFunc that splits at the delimiter, and keeps the delimiter at the end of each
+// token when keep is true.
+func scanDelimited(delim byte, keep bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			if keep {
+				return i + 1, data[:i+1], nil
+			}
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
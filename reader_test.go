@@ -0,0 +1,131 @@
+package iterator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing/iotest"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromLines() {
+	log := strings.NewReader("INFO started\nERROR disk full\nINFO stopped\nERROR out of memory\n")
+
+	errs := Map[string](Filter[string](FromLines(log, ReaderOptions{}), func(line string) bool {
+		return strings.HasPrefix(line, "ERROR ")
+	}), func(line string) string {
+		return strings.TrimPrefix(line, "ERROR ")
+	})
+
+	_ = ForEach[string](errs, func(v string) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// disk full
+	// out of memory
+}
+
+func ExampleFromScanner() {
+	s := bufio.NewScanner(strings.NewReader("the quick  brown\nfox"))
+	s.Split(bufio.ScanWords)
+
+	words, err := ToSlice[string](FromScanner(s))
+	fmt.Println(words, err)
+
+	// Output:
+	// [the quick brown fox] <nil>
+}
+
+// Tests
+
+type readerFixture struct {
+	reader io.Reader
+	opts   ReaderOptions
+}
+
+var rd readerFixture
+
+func aReaderWithTheText(text string) error {
+	s, err := strconv.Unquote(`"` + text + `"`)
+	if err != nil {
+		return err
+	}
+	rd.reader = strings.NewReader(s)
+	return nil
+}
+
+func aReaderThatFailsAfterTheText(text string) error {
+	if err := aReaderWithTheText(text); err != nil {
+		return err
+	}
+	rd.reader = io.MultiReader(rd.reader, iotest.ErrReader(errFirst))
+	return nil
+}
+
+func aMaximumTokenSizeOf(n int) {
+	rd.opts.MaxTokenSize = n
+}
+
+func lineEndingsAreKept() {
+	rd.opts.KeepLineEndings = true
+}
+
+func fromLinesIsCalled() {
+	t.resultingStringIterator = FromLines(rd.reader, rd.opts)
+}
+
+func fromDelimitedIsCalledWithTheDelimiter(delim string) {
+	t.resultingStringIterator = FromDelimited(rd.reader, delim[0], rd.opts)
+}
+
+func fromScannerIsCalledWithTheWordSplitFunction() {
+	s := bufio.NewScanner(rd.reader)
+	s.Split(bufio.ScanWords)
+	t.resultingStringIterator = FromScanner(s)
+}
+
+func theFollowingTokensAreRead(expected string) error {
+	var tokens []string
+	for v, b := t.resultingStringIterator.Next(); b; v, b = t.resultingStringIterator.Next() {
+		tokens = append(tokens, v)
+	}
+	if got := fmt.Sprintf("%q", tokens); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func errorOfTheStringIteratorReturnsErrTooLong() error {
+	if err := t.resultingStringIterator.Error(); !errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("expected: %v got: %v", bufio.ErrTooLong, err)
+	}
+	return nil
+}
+
+func errorOfTheStringIteratorReturnsTheErrorOfTheReader() error {
+	if err := t.resultingStringIterator.Error(); !errors.Is(err, errFirst) {
+		return fmt.Errorf("expected: %v got: %v", errFirst, err)
+	}
+	return nil
+}
+
+func initializeReaderScenario(ctx *godog.ScenarioContext) {
+	rd = readerFixture{}
+	ctx.Step(`^a reader with the text "([^"]*)"$`, aReaderWithTheText)
+	ctx.Step(`^a reader that fails after the text "([^"]*)"$`, aReaderThatFailsAfterTheText)
+	ctx.Step(`^a maximum token size of (\d+)$`, aMaximumTokenSizeOf)
+	ctx.Step(`^line endings are kept$`, lineEndingsAreKept)
+	ctx.Step(`^FromLines is called$`, fromLinesIsCalled)
+	ctx.Step(`^FromDelimited is called with the delimiter "(.)"$`, fromDelimitedIsCalledWithTheDelimiter)
+	ctx.Step(`^FromScanner is called with the word split function$`, fromScannerIsCalledWithTheWordSplitFunction)
+	ctx.Step(`^the following tokens are read: (.*)$`, theFollowingTokensAreRead)
+	ctx.Step(`^Error\(\) of the string iterator returns bufio\.ErrTooLong$`, errorOfTheStringIteratorReturnsErrTooLong)
+	ctx.Step(`^Error\(\) of the string iterator returns the error of the reader$`, errorOfTheStringIteratorReturnsTheErrorOfTheReader)
+}
@@ -0,0 +1,109 @@
+package iterator
+
+import "iter"
+
+// Pair is a generic struct that holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	// First contains the first value of the pair.
+	First A
+	// Second contains the second value of the pair.
+	Second B
+}
+
+// Seq returns an iter.Seq that yields the values of the provided Iterable, so it can be used in a range-over-func
+// loop. The loop may be exited early with break. Error of the Iterable needs to be checked after the loop, use SeqErr
+// to receive the error inside the loop. The Iterable is not closed, use Finish after the loop to close it.
+func Seq[T any](iter Iterable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, b := iter.Next(); b; v, b = iter.Next() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqErr returns an iter.Seq2 that yields the values of the provided Iterable paired with a nil error.
+// When the Iterable returns an error after the iteration has completed, a final zero value of T is yielded together
+// with that error.
+func SeqErr[T any](iter Iterable[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v, b := iter.Next(); b; v, b = iter.Next() {
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err := iter.Error(); err != nil {
+			var t T
+			yield(t, err)
+		}
+	}
+}
+
+// PullIterator is a generic struct implementing an iterator that pulls values from an iter.Seq with iter.Pull.
+type PullIterator[T any] struct {
+	// next contains the closure returned by iter.Pull that returns the next value
+	next func() (T, bool)
+	// stop contains the closure returned by iter.Pull that ends the iteration
+	stop func()
+	// done contains true when the iteration has ended
+	done bool
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+// The underlying iter.Pull is stopped as soon as no more values are available.
+func (iter *PullIterator[T]) Next() (T, bool) {
+	v, b := iter.next()
+	if !b {
+		iter.Stop()
+	}
+	return v, b
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The PullIterator never returns an error.
+func (iter *PullIterator[T]) Error() error {
+	return nil
+}
+
+// Close stops the iteration like Stop does. Close always returns nil.
+func (iter *PullIterator[T]) Close() error {
+	iter.Stop()
+	return nil
+}
+
+// SizeHint returns exactly zero when the iteration has ended, otherwise nothing is known about the number of values.
+func (iter *PullIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// Stop ends the iteration and releases the resources held by iter.Pull. Stop must be called when the iteration is
+// abandoned before Next returned false. Calling Stop more than once is allowed. After Stop Next returns false.
+func (iter *PullIterator[T]) Stop() {
+	iter.stop()
+	iter.done = true
+}
+
+// FromSeq creates a PullIterator that iterates the provided iter.Seq.
+func FromSeq[T any](seq iter.Seq[T]) *PullIterator[T] {
+	next, stop := iter.Pull(seq)
+	return &PullIterator[T]{
+		next: next,
+		stop: stop,
+	}
+}
+
+// FromSeq2 creates a PullIterator that iterates the provided iter.Seq2 and returns each key and value as a Pair.
+func FromSeq2[K any, V any](seq iter.Seq2[K, V]) *PullIterator[Pair[K, V]] {
+	return FromSeq(func(yield func(Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(Pair[K, V]{First: k, Second: v}) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,187 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleSeq() {
+	// Seq turns the iterator into an iter.Seq which can be used in a range loop.
+	for v := range Seq[int](Sequence(1, 10)) {
+		if v > 3 {
+			break
+		}
+		fmt.Println(v)
+	}
+
+	// Output:
+	// 1
+	// 2
+	// 3
+}
+
+func ExampleSeqErr() {
+	// SeqErr also yields the error of the iterator, so it does not get lost inside the range loop.
+	for v, err := range SeqErr[int](FromSlice([]int{1, 2, 3})) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(v)
+	}
+
+	// Output:
+	// 1
+	// 2
+	// 3
+}
+
+func ExampleFromSeq() {
+	// FromSeq turns an iter.Seq, for example from the slices package, into an iterator.
+	si := FromSeq(slices.Values([]int{3, 1, 2}))
+	// Stop must be called when the iteration could be abandoned early.
+	defer si.Stop()
+
+	_ = ForEach[int](si, func(v int) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// 3
+	// 1
+	// 2
+}
+
+// Tests
+
+type seqFixture struct {
+	ranged    []int
+	rangedErr error
+	finished  bool
+	pairs     Iterable[Pair[int, int]]
+	pull      *PullIterator[int]
+}
+
+var sq seqFixture
+
+func theIterableIsRangedOverWithSeq() {
+	for v := range Seq(t.resultingIntIterator) {
+		sq.ranged = append(sq.ranged, v)
+	}
+}
+
+func theIterableIsRangedOverWithSeqUntilValuesAreReceived(n int) {
+	for v := range Seq(t.resultingIntIterator) {
+		sq.ranged = append(sq.ranged, v)
+		if len(sq.ranged) == n {
+			break
+		}
+	}
+}
+
+func theIterableIsRangedOverWithSeqErr() {
+	sq.ranged = nil
+	sq.rangedErr = nil
+	for v, err := range SeqErr(t.resultingIntIterator) {
+		if err != nil {
+			sq.rangedErr = err
+			break
+		}
+		sq.ranged = append(sq.ranged, v)
+	}
+}
+
+func theRangedValuesAre(values string) error {
+	expected, err := valuesStringToIntSlice(values)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(expected, sq.ranged) {
+		return fmt.Errorf("expected: %v got: %v", expected, sq.ranged)
+	}
+	return nil
+}
+
+func theRangedErrorIsNotNil() error {
+	if sq.rangedErr == nil {
+		return errors.New("expected an error but got nil")
+	}
+	return nil
+}
+
+func theRangedErrorIsNil() error {
+	if sq.rangedErr != nil {
+		return fmt.Errorf("expected nil but got: %v", sq.rangedErr)
+	}
+	return nil
+}
+
+// finishing wraps seq and records when seq has returned.
+func finishing[T any](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		defer func() {
+			sq.finished = true
+		}()
+		seq(yield)
+	}
+}
+
+func fromSeqIsCalledWithTheValuesOfTheSlice() {
+	sq.pull = FromSeq(finishing(slices.Values(t.slice)))
+	t.resultingIntIterator = sq.pull
+}
+
+func fromSeq2IsCalledWithTheIndexesAndValuesOfTheSlice() {
+	sq.pairs = FromSeq2(slices.All(t.slice))
+}
+
+func theReturnedPairsAre(pairs string) error {
+	var results []string
+	for v, b := sq.pairs.Next(); b; v, b = sq.pairs.Next() {
+		results = append(results, fmt.Sprintf("%d:%d", v.First, v.Second))
+	}
+	if pairs != strings.Join(results, ",") {
+		return fmt.Errorf("expected: %v got: %v", pairs, results)
+	}
+	return nil
+}
+
+func nextIsCalledOnce() {
+	t.resultingIntIterator.Next()
+}
+
+func stopIsCalled() {
+	sq.pull.Stop()
+}
+
+func theSeqHasFinished() error {
+	if !sq.finished {
+		return errors.New("expected the Seq to be finished")
+	}
+	return nil
+}
+
+func initializeSeqScenario(ctx *godog.ScenarioContext) {
+	sq = seqFixture{}
+
+	ctx.Step(`^the Iterable is ranged over with Seq$`, theIterableIsRangedOverWithSeq)
+	ctx.Step(`^the Iterable is ranged over with Seq until (\d+) values are received$`, theIterableIsRangedOverWithSeqUntilValuesAreReceived)
+	ctx.Step(`^the Iterable is ranged over with SeqErr$`, theIterableIsRangedOverWithSeqErr)
+	ctx.Step(`^the ranged values are: "([^"]*)"$`, theRangedValuesAre)
+	ctx.Step(`^the ranged error is not nil$`, theRangedErrorIsNotNil)
+	ctx.Step(`^the ranged error is nil$`, theRangedErrorIsNil)
+	ctx.Step(`^FromSeq is called with the values of the slice$`, fromSeqIsCalledWithTheValuesOfTheSlice)
+	ctx.Step(`^FromSeq2 is called with the indexes and values of the slice$`, fromSeq2IsCalledWithTheIndexesAndValuesOfTheSlice)
+	ctx.Step(`^the returned pairs are: "([^"]*)"$`, theReturnedPairsAre)
+	ctx.Step(`^Next\(\) is called once$`, nextIsCalledOnce)
+	ctx.Step(`^Stop is called$`, stopIsCalled)
+	ctx.Step(`^the Seq has finished$`, theSeqHasFinished)
+}
@@ -0,0 +1,78 @@
+package iterator
+
+import "math"
+
+// Size hints
+
+// SizeHinter is implemented by Iterables that can tell how many values remain to be returned.
+type SizeHinter interface {
+	// SizeHint returns the lower and upper bound of the number of values that remain to be returned. The upper bound
+	// is -1 when it is unknown. Exact is true when the number of values is known, then lower equals upper.
+	SizeHint() (lower int, upper int, exact bool)
+}
+
+// SizeHint returns the size hint of the provided Iterable when it implements SizeHinter. Otherwise 0, -1 and false
+// are returned, meaning that nothing is known about the number of values.
+func SizeHint[T any](iter Iterable[T]) (lower int, upper int, exact bool) {
+	if s, ok := iter.(SizeHinter); ok {
+		lower, upper, exact = s.SizeHint()
+		return max(lower, 0), upper, exact && lower >= 0
+	}
+	return 0, -1, false
+}
+
+// exactHint returns the size hint for exactly n values.
+func exactHint(n int) (int, int, bool) {
+	return n, n, true
+}
+
+// upperHint returns the size hint for at most the upper bound of the provided Iterable, used by iterators that can
+// return fewer values than their source.
+func upperHint[T any](iter Iterable[T]) (int, int, bool) {
+	_, upper, _ := SizeHint(iter)
+	return 0, upper, upper == 0
+}
+
+// mapHint applies f to the lower and the known upper bound of a size hint. The lower bound is never negative.
+func mapHint(lower int, upper int, f func(int) int) (int, int, bool) {
+	lower = max(f(lower), 0)
+	if upper >= 0 {
+		upper = f(upper)
+	}
+	return lower, upper, lower == upper
+}
+
+// ceilDiv returns n divided by k rounded up, without overflowing for large n.
+func ceilDiv(n int, k int) int {
+	return n/k + min(n%k, 1)
+}
+
+// addHint adds two bounds, where -1 is an unknown upper bound. The sum saturates at math.MaxInt.
+func addHint(a int, b int) int {
+	if a < 0 || b < 0 {
+		return -1
+	}
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+// minHint returns the smallest of two upper bounds, where -1 is an unknown upper bound.
+func minHint(a int, b int) int {
+	if a < 0 {
+		return b
+	}
+	if b < 0 {
+		return a
+	}
+	return min(a, b)
+}
+
+// maxHint returns the largest of two upper bounds, where -1 is an unknown upper bound.
+func maxHint(a int, b int) int {
+	if a < 0 || b < 0 {
+		return -1
+	}
+	return max(a, b)
+}
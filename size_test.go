@@ -0,0 +1,119 @@
+package iterator
+
+import (
+	"fmt"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleSizeHint() {
+	iter := Take[int](Skip[int](Sequence(1, 100), 10), 20)
+	fmt.Println(SizeHint[int](iter))
+
+	odd := Filter[int](iter, func(v int) bool {
+		return v%2 == 1
+	})
+	fmt.Println(SizeHint[int](odd))
+
+	// Output:
+	// 20 20 true
+	// 0 20 false
+}
+
+// Tests
+
+func sizeHintIs(l, u int, e bool, lower, upper int, exact string) error {
+	if l != lower || u != upper || fmt.Sprint(e) != exact {
+		return fmt.Errorf("expected: %v, %v and %v got: %v, %v and %v", lower, upper, exact, l, u, e)
+	}
+	return nil
+}
+
+func sizeHintOfTheIntIteratorReturnsAnd(lower, upper int, exact string) error {
+	l, u, e := SizeHint(t.resultingIntIterator)
+	return sizeHintIs(l, u, e, lower, upper, exact)
+}
+
+func sizeHintOfTheStringIteratorReturnsAnd(lower, upper int, exact string) error {
+	l, u, e := SizeHint(t.resultingStringIterator)
+	return sizeHintIs(l, u, e, lower, upper, exact)
+}
+
+func sizeHintOfTheSliceIteratorReturnsAnd(lower, upper int, exact string) error {
+	l, u, e := SizeHint(ck.slices)
+	return sizeHintIs(l, u, e, lower, upper, exact)
+}
+
+func sizeHintOfTheZippedIteratorReturnsAnd(lower, upper int, exact string) error {
+	l, u, e := SizeHint(zp.zipped)
+	return sizeHintIs(l, u, e, lower, upper, exact)
+}
+
+func nextOfTheIntIteratorIsCalledTimes(n int) {
+	for i := 0; i < n; i++ {
+		t.resultingIntIterator.Next()
+	}
+}
+
+func aBufferedChannelWithTheFollowingValues(listofints *godog.Table) error {
+	values, err := toSliceOfInts(listofints)
+	if err != nil {
+		return err
+	}
+	t.channel = make(chan int, len(values))
+	for _, v := range values {
+		t.channel <- v
+	}
+	close(t.channel)
+	return nil
+}
+
+func collectIsCalledWithAppending() (err error) {
+	t.resultingSlice, err = Collect(t.resultingIntIterator, Appending[int]())
+	return
+}
+
+func takeOfTheSliceIteratorIsCalledWith(n int) {
+	ck.slices = Take(ck.slices, n)
+}
+
+func collectWithAppendingOfTheSliceIteratorReturnsSlices(expected int) error {
+	slices, err := Collect(ck.slices, Appending[[]int]())
+	if err != nil {
+		return err
+	}
+	if len(slices) != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, len(slices))
+	}
+	return nil
+}
+
+func theCapacityOfTheReturnedSliceIs(expected int) error {
+	if cap(t.resultingSlice) != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, cap(t.resultingSlice))
+	}
+	return nil
+}
+
+func theReturnedSliceIsNil() error {
+	if t.resultingSlice != nil {
+		return fmt.Errorf("expected nil got: %v", t.resultingSlice)
+	}
+	return nil
+}
+
+func initializeSizeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^SizeHint of the int iterator returns (\d+), (-?\d+) and (true|false)$`, sizeHintOfTheIntIteratorReturnsAnd)
+	ctx.Step(`^SizeHint of the string iterator returns (\d+), (-?\d+) and (true|false)$`, sizeHintOfTheStringIteratorReturnsAnd)
+	ctx.Step(`^SizeHint of the slice iterator returns (\d+), (-?\d+) and (true|false)$`, sizeHintOfTheSliceIteratorReturnsAnd)
+	ctx.Step(`^SizeHint of the zipped iterator returns (\d+), (-?\d+) and (true|false)$`, sizeHintOfTheZippedIteratorReturnsAnd)
+	ctx.Step(`^Next\(\) of the int iterator is called (\d+) times$`, nextOfTheIntIteratorIsCalledTimes)
+	ctx.Step(`^a buffered channel with the following values:$`, aBufferedChannelWithTheFollowingValues)
+	ctx.Step(`^Collect is called with Appending$`, collectIsCalledWithAppending)
+	ctx.Step(`^Take of the slice iterator is called with (\d+)$`, takeOfTheSliceIteratorIsCalledWith)
+	ctx.Step(`^Collect with Appending of the slice iterator returns (\d+) slices$`, collectWithAppendingOfTheSliceIteratorReturnsSlices)
+	ctx.Step(`^the capacity of the returned slice is (\d+)$`, theCapacityOfTheReturnedSliceIs)
+	ctx.Step(`^the returned slice is nil$`, theReturnedSliceIsNil)
+}
@@ -0,0 +1,285 @@
+package iterator
+
+// Take
+
+// TakeIterator is a struct the implements an Iterable that returns at most n values of the source Iterable.
+type TakeIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// n contains the maximum number of values to return.
+	n int
+	// count contains the number of values returned.
+	count int
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// No values are pulled from the source Iterable after n values are returned.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *TakeIterator[T]) Next() (T, bool) {
+	if iter.count >= iter.n {
+		var t T
+		return t, false
+	}
+	v, b := iter.srcItr.Next()
+	if b {
+		iter.count++
+	}
+	return v, b
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *TakeIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *TakeIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the size hint of the source Iterable limited to the number of values that remain to be taken.
+func (iter *TakeIterator[T]) SizeHint() (int, int, bool) {
+	lower, upper, _ := SizeHint(iter.srcItr)
+	n := iter.n - iter.count
+	lower, upper = min(lower, n), minHint(upper, n)
+	return lower, upper, lower == upper
+}
+
+// Take accepts an Iterable and a count and creates a TakeIterator that returns at most n values of the provided
+// Iterable. A count smaller than 0 is treated as 0.
+func Take[T any](iter Iterable[T], n int) *TakeIterator[T] {
+	return &TakeIterator[T]{
+		srcItr: iter,
+		n:      max(n, 0),
+	}
+}
+
+// Skip
+
+// SkipIterator is a struct the implements an Iterable that skips the first n values of the source Iterable.
+type SkipIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// n contains the number of values to skip.
+	n int
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// The first call to Next pulls and discards n values from the source Iterable.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *SkipIterator[T]) Next() (T, bool) {
+	for ; iter.n > 0; iter.n-- {
+		if v, b := iter.srcItr.Next(); !b {
+			iter.n = 0
+			return v, b
+		}
+	}
+	return iter.srcItr.Next()
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *SkipIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *SkipIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the size hint of the source Iterable minus the number of values that remain to be skipped.
+func (iter *SkipIterator[T]) SizeHint() (int, int, bool) {
+	lower, upper, _ := SizeHint(iter.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		return max(n-iter.n, 0)
+	})
+}
+
+// Skip accepts an Iterable and a count and creates a SkipIterator that returns the values of the provided Iterable
+// after the first n values. A count smaller than 0 is treated as 0.
+func Skip[T any](iter Iterable[T], n int) *SkipIterator[T] {
+	return &SkipIterator[T]{
+		srcItr: iter,
+		n:      max(n, 0),
+	}
+}
+
+// TakeWhile
+
+// TakeWhileIterator is a struct the implements an Iterable that returns values of the source Iterable as long as
+// the predicate returns true.
+type TakeWhileIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// predicate is the closure that determines if the iteration continues.
+	predicate PredicateFunc[T]
+	// done contains true when the predicate returned false.
+	done bool
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// No values are pulled from the source Iterable after the predicate returned false.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *TakeWhileIterator[T]) Next() (T, bool) {
+	var t T
+	if iter.done {
+		return t, false
+	}
+	v, b := iter.srcItr.Next()
+	if !b || !iter.predicate(v) {
+		iter.done = true
+		return t, false
+	}
+	return v, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *TakeWhileIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *TakeWhileIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the upper bound of the source Iterable, because the predicate can stop the iteration early.
+func (iter *TakeWhileIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return upperHint(iter.srcItr)
+}
+
+// TakeWhile accepts an Iterable and PredicateFunc closure and creates a TakeWhileIterator that returns the values
+// of the provided Iterable until the predicate returns false for the first time.
+func TakeWhile[T any](iter Iterable[T], predicate PredicateFunc[T]) *TakeWhileIterator[T] {
+	return &TakeWhileIterator[T]{
+		srcItr:    iter,
+		predicate: predicate,
+	}
+}
+
+// DropWhile
+
+// DropWhileIterator is a struct the implements an Iterable that skips values of the source Iterable as long as the
+// predicate returns true.
+type DropWhileIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// predicate is the closure that determines if values are still skipped.
+	predicate PredicateFunc[T]
+	// dropped contains true when the predicate returned false.
+	dropped bool
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// The predicate is not called anymore after it returned false for the first time.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *DropWhileIterator[T]) Next() (T, bool) {
+	if iter.dropped {
+		return iter.srcItr.Next()
+	}
+	for v, b := iter.srcItr.Next(); b; v, b = iter.srcItr.Next() {
+		if !iter.predicate(v) {
+			iter.dropped = true
+			return v, true
+		}
+	}
+	var t T
+	return t, false
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *DropWhileIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *DropWhileIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the size hint of the source Iterable once the predicate returned false, before that only the
+// upper bound, because values can be dropped.
+func (iter *DropWhileIterator[T]) SizeHint() (int, int, bool) {
+	if iter.dropped {
+		return SizeHint(iter.srcItr)
+	}
+	return upperHint(iter.srcItr)
+}
+
+// DropWhile accepts an Iterable and PredicateFunc closure and creates a DropWhileIterator that skips the values of
+// the provided Iterable until the predicate returns false for the first time, and returns all values from there.
+func DropWhile[T any](iter Iterable[T], predicate PredicateFunc[T]) *DropWhileIterator[T] {
+	return &DropWhileIterator[T]{
+		srcItr:    iter,
+		predicate: predicate,
+	}
+}
+
+// StepBy
+
+// StepByIterator is a struct the implements an Iterable that returns the first and then every k-th value of the
+// source Iterable.
+type StepByIterator[T any] struct {
+	// srcItr is the Iterable this iterator pulls the original values from.
+	srcItr Iterable[T]
+	// k contains the step size.
+	k int
+	// started contains true when the first value is returned.
+	started bool
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// The k-1 values of the source Iterable between the returned values are discarded.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+func (iter *StepByIterator[T]) Next() (T, bool) {
+	if iter.started {
+		for n := 1; n < iter.k; n++ {
+			if v, b := iter.srcItr.Next(); !b {
+				return v, b
+			}
+		}
+	}
+	iter.started = true
+	return iter.srcItr.Next()
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *StepByIterator[T]) Error() error {
+	return iter.srcItr.Error()
+}
+
+// Close closes the source Iterable when it implements io.Closer.
+func (iter *StepByIterator[T]) Close() error {
+	return Close(iter.srcItr)
+}
+
+// SizeHint returns the number of values that remain when stepping through the size hint of the source Iterable.
+func (iter *StepByIterator[T]) SizeHint() (int, int, bool) {
+	lower, upper, _ := SizeHint(iter.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		if iter.started {
+			return n / iter.k
+		}
+		return ceilDiv(n, iter.k)
+	})
+}
+
+// StepBy accepts an Iterable and a step size and creates a StepByIterator that returns the first value of the
+// provided Iterable and then every k-th value. A step size smaller than 1 is treated as 1.
+func StepBy[T any](iter Iterable[T], k int) *StepByIterator[T] {
+	if k < 1 {
+		k = 1
+	}
+	return &StepByIterator[T]{
+		srcItr: iter,
+		k:      k,
+	}
+}
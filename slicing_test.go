@@ -0,0 +1,67 @@
+package iterator
+
+import (
+	"fmt"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleTake() {
+	// Generate an endless sequence of powers of two, Take stops the iteration after 5 values.
+	double := func(p int, c, r uint64) int {
+		if c == 0 {
+			return 1
+		}
+		return p * 2
+	}
+	ti := Take[int](Generate(0, ^uint64(0), double), 5)
+
+	_ = ForEach[int](ti, func(v int) {
+		fmt.Println(v)
+	})
+
+	// Output:
+	// 1
+	// 2
+	// 4
+	// 8
+	// 16
+}
+
+// Tests
+
+func takeIsCalledWith(n int) {
+	t.resultingIntIterator = Take(t.resultingIntIterator, n)
+}
+
+func skipIsCalledWith(n int) {
+	t.resultingIntIterator = Skip(t.resultingIntIterator, n)
+}
+
+func lessThan(n int) PredicateFunc[int] {
+	return func(v int) bool {
+		return v < n
+	}
+}
+
+func takeWhileIsCalledWithAPredicateThatSelectsValuesLessThan(n int) {
+	t.resultingIntIterator = TakeWhile(t.resultingIntIterator, lessThan(n))
+}
+
+func dropWhileIsCalledWithAPredicateThatSelectsValuesLessThan(n int) {
+	t.resultingIntIterator = DropWhile(t.resultingIntIterator, lessThan(n))
+}
+
+func stepByIsCalledWith(k int) {
+	t.resultingIntIterator = StepBy(t.resultingIntIterator, k)
+}
+
+func initializeSlicingScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^Take is called with (-?\d+)$`, takeIsCalledWith)
+	ctx.Step(`^Skip is called with (-?\d+)$`, skipIsCalledWith)
+	ctx.Step(`^TakeWhile is called with a predicate that selects values less than (\d+)$`, takeWhileIsCalledWithAPredicateThatSelectsValuesLessThan)
+	ctx.Step(`^DropWhile is called with a predicate that selects values less than (\d+)$`, dropWhileIsCalledWithAPredicateThatSelectsValuesLessThan)
+	ctx.Step(`^StepBy is called with (\d+)$`, stepByIsCalledWith)
+}
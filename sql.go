@@ -0,0 +1,200 @@
+package iterator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SQL
+
+// ScanFunc is the closure type that is provided to FromRows. It scans the current row of the sql.Rows into a value.
+type ScanFunc[T any] func(rows *sql.Rows) (T, error)
+
+// RowsIterator is a struct the implements an Iterable that returns the rows of an sql.Rows scanned into values.
+type RowsIterator[T any] struct {
+	// rows contains the sql.Rows the values are scanned from
+	rows *sql.Rows
+	// scan contains the closure that scans a row
+	scan ScanFunc[T]
+	// done is true when the rows are closed
+	done bool
+	// err contains the error that occurred during iteration or scanning
+	err error
+}
+
+// Next returns the first or next value of T and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of T and false is returned.
+// The rows are closed when no more rows are available or an error has occurred.
+func (iter *RowsIterator[T]) Next() (T, bool) {
+	var t T
+	if iter.done {
+		return t, false
+	}
+	if !iter.rows.Next() {
+		iter.err = join(iter.rows.Err(), iter.Close())
+		return t, false
+	}
+	v, err := iter.scan(iter.rows)
+	if err != nil {
+		iter.err = join(err, iter.Close())
+		return t, false
+	}
+	return v, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error of the sql.Rows, of the ScanFunc closure, or of closing the rows is returned.
+func (iter *RowsIterator[T]) Error() error {
+	return iter.err
+}
+
+// Close closes the sql.Rows, so an early stop releases the connection. After Close, Next returns false.
+func (iter *RowsIterator[T]) Close() error {
+	iter.done = true
+	return iter.rows.Close()
+}
+
+// SizeHint returns exactly zero when the rows are closed, otherwise nothing is known about the number of rows.
+func (iter *RowsIterator[T]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromRows accepts an sql.Rows and a ScanFunc closure and returns a RowsIterator that returns each row scanned with
+// the closure. The rows are closed when they are exhausted, when an error occurred, or when the iterator is closed,
+// for example by a terminal operation that stops early. The error of rows.Err is returned by Error.
+func FromRows[T any](rows *sql.Rows, scan ScanFunc[T]) *RowsIterator[T] {
+	return &RowsIterator[T]{rows: rows, scan: scan}
+}
+
+// ScanStruct is a ScanFunc that scans the current row into a struct of type T. The columns are mapped to the struct
+// fields by the name in the db tag, or by the field name when there is no tag, ignoring case. Fields with the tag
+// "-" are skipped and columns without a field are discarded. The fields must be of a type that sql.Rows.Scan
+// supports for the column, use pointers or sql.Null types for columns that can be NULL.
+func ScanStruct[T any](rows *sql.Rows) (T, error) {
+	var t T
+	v := reflect.ValueOf(&t).Elem()
+	if v.Kind() != reflect.Struct {
+		return t, fmt.Errorf("iterator: %v is not a struct", v.Type())
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return t, err
+	}
+	dest := make([]any, len(columns))
+	for i, column := range columns {
+		if f, ok := dbField(v, column); ok {
+			dest[i] = f.Addr().Interface()
+		} else {
+			dest[i] = new(any)
+		}
+	}
+	if err := rows.Scan(dest...); err != nil {
+		var zero T
+		return zero, err
+	}
+	return t, nil
+}
+
+// dbField returns the field of the struct that is mapped to the column.
+func dbField(v reflect.Value, column string) (reflect.Value, bool) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := f.Tag.Get("db")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if strings.EqualFold(name, column) {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// BuildStmtFunc is the closure type that is provided to ToSQLBatches. It receives a batch of values and returns the
+// statement that writes them and the arguments of the statement.
+type BuildStmtFunc[T any] func(batch []T) (query string, args []any)
+
+// SQLBatchOptions contains the options of ToSQLBatches.
+type SQLBatchOptions struct {
+	// Retry decides if a failed batch is retried. When Retry is nil failed batches are not retried.
+	Retry BackoffFunc
+	// ContinueOnError continues with the next batch when a batch failed, instead of stopping.
+	ContinueOnError bool
+}
+
+// BatchError is the error that is returned by ToSQLBatches for a batch that failed.
+type BatchError struct {
+	// Start is the index of the first value of the batch, starting at 0.
+	Start int
+	// End is the index after the last value of the batch.
+	End int
+	// Err is the error of the failed batch.
+	Err error
+}
+
+// Error returns the error message with the range of values of the batch.
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("iterator: batch of values %d to %d: %v", e.Start, e.End, e.Err)
+}
+
+// Unwrap returns the error of the failed batch.
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// ToSQLBatches writes the values of the Iterable to the database in batches of batchSize values. The statement of
+// each batch is built by the BuildStmtFunc closure and executed in its own transaction. When a batch fails, the
+// transaction is rolled back and a *BatchError with the range of the values of the batch is returned. With the
+// ContinueOnError option the remaining batches are still written, and the errors of all failed batches are
+// returned joined. The Iterable is closed when the iteration is done.
+func ToSQLBatches[T any](iter Iterable[T], db *sql.DB, batchSize int, build BuildStmtFunc[T], opts SQLBatchOptions) error {
+	return ToSQLBatchesContext(context.Background(), iter, db, batchSize, build, opts)
+}
+
+// ToSQLBatchesContext is ToSQLBatches with a context that is used for the transactions. When the context is done,
+// the batch that is being written fails and no more batches are written.
+func ToSQLBatchesContext[T any](ctx context.Context, iter Iterable[T], db *sql.DB, batchSize int, build BuildStmtFunc[T], opts SQLBatchOptions) error {
+	batches := Chunk(iter, batchSize)
+	var err error
+	start := 0
+	for batch, b := batches.Next(); b; batch, b = batches.Next() {
+		query, args := build(batch)
+		batchErr := retry(ctx, opts.Retry, func() error {
+			return execTx(ctx, db, query, args)
+		})
+		if batchErr != nil {
+			err = join(err, &BatchError{Start: start, End: start + len(batch), Err: batchErr})
+			if !opts.ContinueOnError || ctx.Err() != nil {
+				return join(err, Close[[]T](batches))
+			}
+		}
+		start += len(batch)
+	}
+	return join(err, Finish[[]T](batches))
+}
+
+// execTx executes the statement in a transaction, which is rolled back when the statement fails.
+func execTx(ctx context.Context, db *sql.DB, query string, args []any) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		if rbErr := tx.Rollback(); !errors.Is(rbErr, sql.ErrTxDone) {
+			return join(err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
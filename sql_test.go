@@ -0,0 +1,469 @@
+package iterator
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cucumber/godog"
+)
+
+// Fake driver
+
+// fakeTable contains the rows that the fake driver returns for a query.
+type fakeTable struct {
+	columns []string
+	rows    [][]driver.Value
+	// failAt is the index of the row at which reading fails, -1 when reading does not fail
+	failAt int
+}
+
+// fakeDatabase contains the state of the fake driver.
+type fakeDatabase struct {
+	mu sync.Mutex
+	// tables contains the tables by query
+	tables map[string]*fakeTable
+	// closed counts the closed rows
+	closed int
+	// executed contains the executed statements with their arguments, and the commits and rollbacks
+	executed []string
+	// failures is the number of statements that fail before statements succeed again
+	failures int
+	// failValue makes every statement with this argument fail
+	failValue driver.Value
+}
+
+var fdb = &fakeDatabase{tables: map[string]*fakeTable{}}
+
+var errFakeRows = errors.New("fake rows failed")
+
+var errFakeExec = errors.New("fake statement failed")
+
+func init() {
+	sql.Register("fake", fakeDriver{})
+}
+
+// fakeDriver is a database/sql/driver that returns the rows of the fake tables, and records the executed
+// statements.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{query: query}, nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error {
+	fdb.record("COMMIT")
+	return nil
+}
+
+func (fakeTx) Rollback() error {
+	fdb.record("ROLLBACK")
+	return nil
+}
+
+// record records an executed statement.
+func (d *fakeDatabase) record(stmt string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.executed = append(d.executed, stmt)
+}
+
+type fakeStmt struct {
+	query string
+}
+
+func (fakeStmt) Close() error {
+	return nil
+}
+
+func (fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	fdb.executed = append(fdb.executed, fmt.Sprint(s.query, args))
+	if fdb.failures > 0 {
+		fdb.failures--
+		return nil, errFakeExec
+	}
+	for _, arg := range args {
+		if fdb.failValue != nil && arg == fdb.failValue {
+			return nil, errFakeExec
+		}
+	}
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	table, ok := fdb.tables[s.query]
+	if !ok {
+		return nil, fmt.Errorf("unknown table: %v", s.query)
+	}
+	return &fakeRows{table: table}, nil
+}
+
+type fakeRows struct {
+	table *fakeTable
+	idx   int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.table.columns
+}
+
+func (r *fakeRows) Close() error {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	fdb.closed++
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.idx == r.table.failAt {
+		return errFakeRows
+	}
+	if r.idx >= len(r.table.rows) {
+		return io.EOF
+	}
+	copy(dest, r.table.rows[r.idx])
+	r.idx++
+	return nil
+}
+
+// openFakeDatabase opens a database that uses the fake driver.
+func openFakeDatabase() *sql.DB {
+	db, err := sql.Open("fake", "")
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+// Examples
+
+func ExampleFromRows() {
+	fdb.tables["SELECT id, name FROM users"] = &fakeTable{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{int64(1), "alice"}, {int64(2), "bob"}},
+		failAt:  -1,
+	}
+
+	type user struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+
+	db := openFakeDatabase()
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT id, name FROM users")
+	if err != nil {
+		panic(err)
+	}
+
+	// The rows are closed by ForEach, and rows.Err is returned.
+	err = ForEach[user](FromRows(rows, ScanStruct[user]), func(u user) {
+		fmt.Println(u.ID, u.Name)
+	})
+	fmt.Println(err)
+
+	// Output:
+	// 1 alice
+	// 2 bob
+	// <nil>
+}
+
+func ExampleToSQLBatches() {
+	db := openFakeDatabase()
+	defer db.Close()
+
+	insert := func(batch []string) (string, []any) {
+		query := "INSERT INTO tags (name) VALUES " + strings.TrimSuffix(strings.Repeat("(?),", len(batch)), ",")
+		args := make([]any, len(batch))
+		for i, v := range batch {
+			args[i] = v
+		}
+		return query, args
+	}
+
+	tags := FromSlice([]string{"go", "sql", "iterator", "batch", "example"})
+
+	// Each batch of 2 values is inserted in its own transaction, a failed batch is retried up to 3 attempts.
+	err := ToSQLBatches[string](tags, db, 2, insert, SQLBatchOptions{
+		Retry: ExponentialBackoff(10*time.Millisecond, time.Second, 3),
+	})
+	fmt.Println(err)
+
+	// Output:
+	// <nil>
+}
+
+// Tests
+
+// account is the struct the rows of the tests are scanned into.
+type account struct {
+	ID    int64  `db:"id"`
+	Name  string `db:"name"`
+	Email *string
+	Note  string `db:"-"`
+}
+
+func (a account) String() string {
+	email := "NULL"
+	if a.Email != nil {
+		email = *a.Email
+	}
+	return fmt.Sprintf("%v:%v:%v", a.ID, a.Name, email)
+}
+
+type sqlFixture struct {
+	db       *sql.DB
+	accounts Iterable[account]
+	names    Iterable[string]
+	result   string
+	err      error
+	opts     SQLBatchOptions
+}
+
+var sl *sqlFixture
+
+func aDatabaseTableWithTheRows(name string, table *godog.Table) {
+	ft := &fakeTable{failAt: -1}
+	for _, cell := range table.Rows[0].Cells {
+		ft.columns = append(ft.columns, cell.Value)
+	}
+	for _, row := range table.Rows[1:] {
+		var values []driver.Value
+		for _, cell := range row.Cells {
+			if cell.Value == "NULL" {
+				values = append(values, nil)
+			} else if i, err := strconv.ParseInt(cell.Value, 10, 64); err == nil {
+				values = append(values, i)
+			} else {
+				values = append(values, cell.Value)
+			}
+		}
+		ft.rows = append(ft.rows, values)
+	}
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	fdb.tables[name] = ft
+	fdb.closed = 0
+}
+
+func readingTheTableFailsAtRow(name string, row int) {
+	fdb.tables[name].failAt = row
+}
+
+func query(name string) (*sql.Rows, error) {
+	if sl.db == nil {
+		sl.db = openFakeDatabase()
+	}
+	return sl.db.Query(name)
+}
+
+func fromRowsIsCalledForWithScanStruct(name string) error {
+	rows, err := query(name)
+	if err != nil {
+		return err
+	}
+	sl.accounts = FromRows(rows, ScanStruct[account])
+	return nil
+}
+
+func fromRowsIsCalledForWithAScanFunctionThatReadsTheName(name string) error {
+	rows, err := query(name)
+	if err != nil {
+		return err
+	}
+	sl.names = FromRows(rows, func(rows *sql.Rows) (string, error) {
+		var id int64
+		var name string
+		err := rows.Scan(&id, &name)
+		return name, err
+	})
+	return nil
+}
+
+func theAccountsAreCollected() {
+	var accounts []account
+	accounts, sl.err = ToSlice(sl.accounts)
+	sl.result = fmt.Sprint(accounts)
+}
+
+func theFirstAccountsAreTaken(n int) {
+	var accounts []account
+	accounts, sl.err = ToSlice[account](Take(sl.accounts, n))
+	sl.result = fmt.Sprint(accounts)
+}
+
+func theNamesAreCollected() {
+	var names []string
+	names, sl.err = ToSlice(sl.names)
+	sl.result = strings.Join(names, ",")
+}
+
+func theResultIs(expected string) error {
+	if sl.result != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, sl.result)
+	}
+	return nil
+}
+
+func theRowsAreClosed() error {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	if fdb.closed != 1 {
+		return fmt.Errorf("expected the rows to be closed once, closed: %v", fdb.closed)
+	}
+	return nil
+}
+
+func theErrorOfTheRowsIsReturned() error {
+	if !errors.Is(sl.err, errFakeRows) {
+		return fmt.Errorf("expected: %v got: %v", errFakeRows, sl.err)
+	}
+	return nil
+}
+
+func noErrorIsReturned() error {
+	if sl.err != nil {
+		return fmt.Errorf("expected nil got: %v", sl.err)
+	}
+	return nil
+}
+
+func aScanErrorIsReturned() error {
+	if sl.err == nil || errors.Is(sl.err, errFakeRows) {
+		return fmt.Errorf("expected a scan error got: %v", sl.err)
+	}
+	return nil
+}
+
+// insertValues is a BuildStmtFunc that inserts the values of the batch with a multi-row INSERT.
+func insertValues(batch []int) (string, []any) {
+	query := "INSERT INTO numbers VALUES " + strings.TrimSuffix(strings.Repeat("(?),", len(batch)), ",")
+	args := make([]any, len(batch))
+	for i, v := range batch {
+		args[i] = v
+	}
+	return query, args
+}
+
+func toSQLBatchesIsCalledWithBatchSize(n int) {
+	if sl.db == nil {
+		sl.db = openFakeDatabase()
+	}
+	sl.err = ToSQLBatches(t.resultingIntIterator, sl.db, n, insertValues, sl.opts)
+}
+
+func failedBatchesAreRetriedTimes(n int) {
+	sl.opts.Retry = ExponentialBackoff(time.Millisecond, time.Millisecond, n+1)
+}
+
+func failedBatchesDoNotStopTheWriting() {
+	sl.opts.ContinueOnError = true
+}
+
+func theNextStatementsFail(n int) {
+	fdb.failures = n
+}
+
+func statementsWithTheValueFail(v int) {
+	fdb.failValue = int64(v)
+}
+
+func theExecutedStatementsAre(table *godog.Table) error {
+	var expected []string
+	for _, row := range table.Rows {
+		expected = append(expected, row.Cells[0].Value)
+	}
+	if !reflect.DeepEqual(expected, fdb.executed) {
+		return fmt.Errorf("expected: %q got: %q", expected, fdb.executed)
+	}
+	return nil
+}
+
+// batchErrors returns the BatchErrors in the tree of joined errors.
+func batchErrors(err error) []string {
+	if be, ok := err.(*BatchError); ok {
+		return []string{fmt.Sprintf("%v-%v", be.Start, be.End)}
+	}
+	var result []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			result = append(result, batchErrors(e)...)
+		}
+	}
+	return result
+}
+
+func batchErrorsAreReturnedForTheValues(expected string) error {
+	if got := strings.Join(batchErrors(sl.err), ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v (%v)", expected, got, sl.err)
+	}
+	if !errors.Is(sl.err, errFakeExec) {
+		return fmt.Errorf("expected: %v got: %v", errFakeExec, sl.err)
+	}
+	return nil
+}
+
+func initializeSQLScenario(ctx *godog.ScenarioContext) {
+	sl = &sqlFixture{}
+	fdb.mu.Lock()
+	fdb.executed, fdb.failures, fdb.failValue = nil, 0, nil
+	fdb.mu.Unlock()
+	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
+		if sl.db != nil {
+			return ctx, sl.db.Close()
+		}
+		return ctx, nil
+	})
+	ctx.Step(`^a database table "([^"]*)" with the rows:$`, aDatabaseTableWithTheRows)
+	ctx.Step(`^reading the table "([^"]*)" fails at row (\d+)$`, readingTheTableFailsAtRow)
+	ctx.Step(`^FromRows is called for "([^"]*)" with ScanStruct$`, fromRowsIsCalledForWithScanStruct)
+	ctx.Step(`^FromRows is called for "([^"]*)" with a scan function that reads the name$`, fromRowsIsCalledForWithAScanFunctionThatReadsTheName)
+	ctx.Step(`^the accounts are collected$`, theAccountsAreCollected)
+	ctx.Step(`^the first (\d+) accounts are taken$`, theFirstAccountsAreTaken)
+	ctx.Step(`^the names are collected$`, theNamesAreCollected)
+	ctx.Step(`^the result is "([^"]*)"$`, theResultIs)
+	ctx.Step(`^the rows are closed$`, theRowsAreClosed)
+	ctx.Step(`^the error of the rows is returned$`, theErrorOfTheRowsIsReturned)
+	ctx.Step(`^no error is returned$`, noErrorIsReturned)
+	ctx.Step(`^a scan error is returned$`, aScanErrorIsReturned)
+	ctx.Step(`^ToSQLBatches is called with batch size (\d+)$`, toSQLBatchesIsCalledWithBatchSize)
+	ctx.Step(`^failed batches are retried (\d+) times$`, failedBatchesAreRetriedTimes)
+	ctx.Step(`^failed batches do not stop the writing$`, failedBatchesDoNotStopTheWriting)
+	ctx.Step(`^the next (\d+) statements fail$`, theNextStatementsFail)
+	ctx.Step(`^statements with the value (\d+) fail$`, statementsWithTheValueFail)
+	ctx.Step(`^the executed statements are:$`, theExecutedStatementsAre)
+	ctx.Step(`^BatchErrors are returned for the values "([^"]*)"$`, batchErrorsAreReturnedForTheValues)
+}
@@ -0,0 +1,263 @@
+package iterator
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Statistics
+// All statistics close the Iterable when the iteration is done.
+
+// Sum accepts an Iterable and returns the sum of all values.
+func Sum[T Number](iter Iterable[T]) (T, error) {
+	var sum T
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		sum += v
+	}
+	return sum, Finish(iter)
+}
+
+// Summing returns a Collector that sums the values returned by the MapFunc closure.
+func Summing[T any, N Number](f MapFunc[T, N]) Collector[T, N, N] {
+	return Mapping(f, Reducing(0, func(a N, v N) N {
+		return a + v
+	}))
+}
+
+// Min accepts an Iterable and returns the smallest value and true. When the Iterable has no values, a zero value of
+// T and false is returned. When values are equal, the first value wins.
+func Min[T cmp.Ordered](iter Iterable[T]) (T, bool, error) {
+	return MinBy(iter, identity[T])
+}
+
+// Max accepts an Iterable and returns the largest value and true. When the Iterable has no values, a zero value of
+// T and false is returned. When values are equal, the first value wins.
+func Max[T cmp.Ordered](iter Iterable[T]) (T, bool, error) {
+	return MaxBy(iter, identity[T])
+}
+
+// MinBy accepts an Iterable and a MapFunc closure that returns the key of a value, and returns the value with the
+// smallest key and true. When the Iterable has no values, a zero value of T and false is returned. When keys are
+// equal, the first value wins.
+func MinBy[T any, K cmp.Ordered](iter Iterable[T], key MapFunc[T, K]) (T, bool, error) {
+	return extremeBy(iter, key, -1)
+}
+
+// MaxBy accepts an Iterable and a MapFunc closure that returns the key of a value, and returns the value with the
+// largest key and true. When the Iterable has no values, a zero value of T and false is returned. When keys are
+// equal, the first value wins.
+func MaxBy[T any, K cmp.Ordered](iter Iterable[T], key MapFunc[T, K]) (T, bool, error) {
+	return extremeBy(iter, key, 1)
+}
+
+// extremeBy returns the first value for which the comparison of its key with the key of the best value so far
+// returns sign.
+func extremeBy[T any, K cmp.Ordered](iter Iterable[T], key MapFunc[T, K], sign int) (T, bool, error) {
+	best, found := iter.Next()
+	if !found {
+		return best, false, Finish(iter)
+	}
+	bestKey := key(best)
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		if k := key(v); cmp.Compare(k, bestKey) == sign {
+			best, bestKey = v, k
+		}
+	}
+	return best, true, Finish(iter)
+}
+
+// welford contains the running count, mean and sum of squared differences of Welford's online algorithm.
+type welford struct {
+	n    float64
+	mean float64
+	m2   float64
+}
+
+// add adds a value to the running statistics.
+func (w *welford) add(x float64) {
+	w.n++
+	delta := x - w.mean
+	w.mean += delta / w.n
+	w.m2 += delta * (x - w.mean)
+}
+
+// summarize returns the running statistics of all values of the Iterable.
+func summarize[T Number](iter Iterable[T]) (welford, error) {
+	var w welford
+	for v, b := iter.Next(); b; v, b = iter.Next() {
+		w.add(float64(v))
+	}
+	return w, Finish(iter)
+}
+
+// Mean accepts an Iterable and returns the arithmetic mean of all values and true. When the Iterable has no values,
+// zero and false is returned.
+func Mean[T Number](iter Iterable[T]) (float64, bool, error) {
+	w, err := summarize(iter)
+	return w.mean, w.n > 0, err
+}
+
+// Variance accepts an Iterable and returns the population variance of all values and true. The variance is
+// calculated in a single pass with Welford's algorithm, which is numerically stable. When the Iterable has no
+// values, zero and false is returned.
+func Variance[T Number](iter Iterable[T]) (float64, bool, error) {
+	w, err := summarize(iter)
+	if w.n == 0 {
+		return 0, false, err
+	}
+	return w.m2 / w.n, true, err
+}
+
+// StdDev accepts an Iterable and returns the population standard deviation of all values and true. When the
+// Iterable has no values, zero and false is returned.
+func StdDev[T Number](iter Iterable[T]) (float64, bool, error) {
+	v, b, err := Variance(iter)
+	return math.Sqrt(v), b, err
+}
+
+// Percentile accepts an Iterable and a percentile between 0 and 100 and returns an approximation of the value at
+// that percentile and true. The approximation is calculated with a TDigest with a compression of 100, so the memory
+// used is bounded regardless of the number of values. When the Iterable has no values, zero and false is returned.
+func Percentile[T Number](iter Iterable[T], p float64) (float64, bool, error) {
+	d, err := Collect(iter, Digesting[T](100))
+	if d.Count() == 0 {
+		return 0, false, err
+	}
+	return d.Quantile(p / 100), true, err
+}
+
+// Digesting returns a Collector that adds the values to a TDigest with the provided compression.
+func Digesting[T Number](compression float64) Collector[T, *TDigest, *TDigest] {
+	return Collector[T, *TDigest, *TDigest]{
+		Supply: func(int) *TDigest {
+			return NewTDigest(compression)
+		},
+		Accumulate: func(d *TDigest, v T) *TDigest {
+			d.Add(float64(v))
+			return d
+		},
+		Finish: identity[*TDigest],
+	}
+}
+
+// TDigest
+
+// centroid contains the mean and the number of values of a cluster of values in a TDigest.
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest is a streaming sketch that approximates quantiles of a large number of values in bounded memory.
+// It is an implementation of the merging t-digest by Ted Dunning. The quantiles near 0 and 1 are more accurate than
+// the quantiles near the median.
+type TDigest struct {
+	// compression bounds the number of centroids, a higher compression is more accurate and uses more memory.
+	compression float64
+	// centroids contains the merged centroids sorted by mean.
+	centroids []centroid
+	// buffer contains the values that are added but not yet merged.
+	buffer []float64
+	// count contains the number of values added.
+	count float64
+	// min contains the smallest value added.
+	min float64
+	// max contains the largest value added.
+	max float64
+}
+
+// NewTDigest creates a TDigest with the provided compression. A compression of 100 is a good default, a
+// compression smaller than 20 is treated as 20.
+func NewTDigest(compression float64) *TDigest {
+	compression = max(compression, 20)
+	return &TDigest{
+		compression: compression,
+		buffer:      make([]float64, 0, int(5*compression)),
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds a value to the TDigest.
+func (d *TDigest) Add(x float64) {
+	d.buffer = append(d.buffer, x)
+	d.count++
+	d.min = min(d.min, x)
+	d.max = max(d.max, x)
+	if len(d.buffer) == cap(d.buffer) {
+		d.merge()
+	}
+}
+
+// Count returns the number of values added to the TDigest.
+func (d *TDigest) Count() int {
+	return int(d.count)
+}
+
+// scale is the k1 scale function of the t-digest, the centroids are merged so that each spans at most one unit of
+// scale. It keeps the centroids near the quantiles 0 and 1 small.
+func (d *TDigest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// merge merges the buffered values into the centroids.
+func (d *TDigest) merge() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := make([]centroid, 0, len(d.centroids)+len(d.buffer))
+	all = append(all, d.centroids...)
+	for _, x := range d.buffer {
+		all = append(all, centroid{mean: x, weight: 1})
+	}
+	d.buffer = d.buffer[:0]
+	slices.SortFunc(all, func(a, b centroid) int {
+		return cmp.Compare(a.mean, b.mean)
+	})
+	merged := make([]centroid, 0, len(d.centroids))
+	cur := all[0]
+	var before float64
+	kLeft := d.scale(0)
+	for _, c := range all[1:] {
+		if d.scale((before+cur.weight+c.weight)/d.count)-kLeft <= 1 {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		merged = append(merged, cur)
+		before += cur.weight
+		kLeft = d.scale(before / d.count)
+		cur = c
+	}
+	d.centroids = append(merged, cur)
+}
+
+// Quantile returns an approximation of the value at quantile q, where q is between 0 and 1. The value is
+// interpolated between the means of the centroids. NaN is returned when no values have been added.
+func (d *TDigest) Quantile(q float64) float64 {
+	d.merge()
+	if d.count == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return d.min
+	}
+	if q >= 1 {
+		return d.max
+	}
+	target := q * d.count
+	// Each centroid is placed at the center of the values it represents, the value before the first centroid is the
+	// minimum and the value after the last centroid is the maximum.
+	prevCenter, prevMean := 0.0, d.min
+	var before float64
+	for _, c := range d.centroids {
+		center := before + c.weight/2
+		if target < center {
+			return prevMean + (c.mean-prevMean)*(target-prevCenter)/(center-prevCenter)
+		}
+		prevCenter, prevMean = center, c.mean
+		before += c.weight
+	}
+	return prevMean + (d.max-prevMean)*(target-prevCenter)/(d.count-prevCenter)
+}
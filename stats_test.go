@@ -0,0 +1,145 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExamplePercentile() {
+	// Latencies in milliseconds, this could be a large stream received from a channel.
+	latencies := Sequence(1, 1000)
+
+	p99, _, _ := Percentile[int](latencies, 99)
+
+	fmt.Printf("%.0f\n", p99)
+
+	// Output:
+	// 990
+}
+
+// Tests
+
+type statsFixture struct {
+	statistic float64
+	found     bool
+	str       string
+	err       error
+}
+
+var st statsFixture
+
+func anEmptyIterable() {
+	t.resultingIntIterator = FromSlice[int](nil)
+}
+
+func sumIsCalled() {
+	var s int
+	s, st.err = Sum(t.resultingIntIterator)
+	st.statistic, st.found = float64(s), true
+}
+
+func minIsCalled() {
+	var m int
+	m, st.found, st.err = Min(t.resultingIntIterator)
+	st.statistic = float64(m)
+}
+
+func maxIsCalled() {
+	var m int
+	m, st.found, st.err = Max(t.resultingIntIterator)
+	st.statistic = float64(m)
+}
+
+func length(s string) int {
+	return len(s)
+}
+
+func minByIsCalledWithTheLengthAsKey() {
+	st.str, st.found, st.err = MinBy(zp.second, length)
+}
+
+func maxByIsCalledWithTheLengthAsKey() {
+	st.str, st.found, st.err = MaxBy(zp.second, length)
+}
+
+func meanIsCalled() {
+	st.statistic, st.found, st.err = Mean(t.resultingIntIterator)
+}
+
+func varianceIsCalled() {
+	st.statistic, st.found, st.err = Variance(t.resultingIntIterator)
+}
+
+func stdDevIsCalled() {
+	st.statistic, st.found, st.err = StdDev(t.resultingIntIterator)
+}
+
+func percentileIsCalledWith(p float64) {
+	st.statistic, st.found, st.err = Percentile(t.resultingIntIterator, p)
+}
+
+func theStatisticIs(expected float64) error {
+	if !st.found || st.err != nil {
+		return fmt.Errorf("expected a statistic, got found: %v error: %v", st.found, st.err)
+	}
+	if st.statistic != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, st.statistic)
+	}
+	return nil
+}
+
+func theStatisticIsWithinOf(percentage, expected float64) error {
+	if !st.found || st.err != nil {
+		return fmt.Errorf("expected a statistic, got found: %v error: %v", st.found, st.err)
+	}
+	if math.Abs(st.statistic-expected) > expected*percentage/100 {
+		return fmt.Errorf("expected: %v got: %v", expected, st.statistic)
+	}
+	return nil
+}
+
+func theFoundStringIs(expected string) error {
+	if !st.found || st.str != expected {
+		return fmt.Errorf("expected: %v got: %v (found: %v)", expected, st.str, st.found)
+	}
+	return nil
+}
+
+func noStatisticIsFound() error {
+	if st.found {
+		return fmt.Errorf("expected no statistic but got: %v", st.statistic)
+	}
+	return nil
+}
+
+func theStatisticReturnedAnError() error {
+	if st.err == nil {
+		return errors.New("expected an error but got nil")
+	}
+	return nil
+}
+
+func initializeStatsScenario(ctx *godog.ScenarioContext) {
+	st = statsFixture{}
+
+	ctx.Step(`^an empty Iterable$`, anEmptyIterable)
+	ctx.Step(`^Sum is called$`, sumIsCalled)
+	ctx.Step(`^Min is called$`, minIsCalled)
+	ctx.Step(`^Max is called$`, maxIsCalled)
+	ctx.Step(`^MinBy is called with the length as key$`, minByIsCalledWithTheLengthAsKey)
+	ctx.Step(`^MaxBy is called with the length as key$`, maxByIsCalledWithTheLengthAsKey)
+	ctx.Step(`^Mean is called$`, meanIsCalled)
+	ctx.Step(`^Variance is called$`, varianceIsCalled)
+	ctx.Step(`^StdDev is called$`, stdDevIsCalled)
+	ctx.Step(`^Percentile is called with (\d+(?:\.\d+)?)$`, percentileIsCalledWith)
+	ctx.Step(`^the statistic is (-?\d+(?:\.\d+)?)$`, theStatisticIs)
+	ctx.Step(`^the statistic is within (\d+(?:\.\d+)?)% of (\d+)$`, theStatisticIsWithinOf)
+	ctx.Step(`^the found string is "([^"]*)"$`, theFoundStringIs)
+	ctx.Step(`^no statistic is found$`, noStatisticIsFound)
+	ctx.Step(`^the statistic returned an error$`, theStatisticReturnedAnError)
+}
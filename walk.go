@@ -0,0 +1,275 @@
+package iterator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+)
+
+// Filesystem
+
+// FSEntry is an entry of a file system that is returned by FromFS.
+type FSEntry struct {
+	// DirEntry contains the name and type of the entry.
+	fs.DirEntry
+	// Path is the path of the entry, the root joined with the names of the directories and the entry.
+	Path string
+	// Depth is the depth of the entry, the root has depth 0.
+	Depth int
+}
+
+// FSOptions contains the options of FromFS.
+type FSOptions struct {
+	// MaxDepth is the maximum depth of the returned entries, the root has depth 0 and the entries in the root have
+	// depth 1. When MaxDepth is 0 the depth is not limited.
+	MaxDepth int
+	// FollowSymlinks follows symbolic links. Links to directories are walked, unless the directory is also an
+	// ancestor of the link. Following symbolic links is only supported for file systems returned by os.DirFS.
+	FollowSymlinks bool
+	// Include contains glob patterns, as supported by path.Match, that are matched against the names of the files.
+	// When Include is not empty only files that match at least one pattern are returned. Directories are always
+	// returned.
+	Include []string
+	// Exclude contains glob patterns, as supported by path.Match, that are matched against the names of the files
+	// and directories. Matching entries are not returned, and matching directories are not walked.
+	Exclude []string
+	// OnError is called with the path and the error when a directory cannot be read or a symbolic link cannot be
+	// followed. When OnError returns nil, the walk continues without that entry. Otherwise the walk stops, and Error
+	// returns the returned error. When OnError is nil the walk stops at the first error.
+	OnError func(path string, err error) error
+}
+
+// dirFrame contains the state of the walk in a directory.
+type dirFrame struct {
+	// path contains the path of the directory
+	path string
+	// depth contains the depth of the directory
+	depth int
+	// info contains the file info of the directory, only used to detect symbolic link loops
+	info fs.FileInfo
+	// entries contains the entries of the directory
+	entries []fs.DirEntry
+	// idx contains the position in entries
+	idx int
+}
+
+// FSIterator is a struct the implements an Iterable that walks a file system lazily.
+type FSIterator struct {
+	// fsys contains the walked file system
+	fsys fs.FS
+	// root contains the path of the root of the walk
+	root string
+	// opts contains the options
+	opts FSOptions
+	// stack contains the directories that are being walked, the current directory is at the top
+	stack []*dirFrame
+	// pending contains the directory that was returned last, which is read on the next call of Next
+	pending *dirFrame
+	// lastDir is true when the entry that was returned last is a directory
+	lastDir bool
+	// started is true when the root has been returned
+	started bool
+	// done is true when the walk is complete or an error occurred
+	done bool
+	// err contains the error that stopped the walk
+	err error
+}
+
+// Next returns the first or next entry of the file system and true if an entry is available.
+// If no more entries are available or an error has occurred then a zero FSEntry and false is returned.
+// Entries are returned in the order of fs.WalkDir: lexical order, each directory before its contents.
+func (iter *FSIterator) Next() (FSEntry, bool) {
+	if iter.done {
+		return FSEntry{}, false
+	}
+	if !iter.started {
+		iter.started = true
+		entry, ok := iter.walkRoot()
+		iter.lastDir = ok && entry.IsDir()
+		return entry, ok
+	}
+	for {
+		if iter.pending != nil {
+			iter.readDir(iter.pending)
+			iter.pending = nil
+			if iter.done {
+				break
+			}
+		}
+		if len(iter.stack) == 0 {
+			iter.done = true
+			break
+		}
+		top := iter.stack[len(iter.stack)-1]
+		if top.idx >= len(top.entries) {
+			iter.stack = iter.stack[:len(iter.stack)-1]
+			continue
+		}
+		e := top.entries[top.idx]
+		top.idx++
+		if entry, ok := iter.visit(top, e); ok {
+			iter.lastDir = entry.IsDir()
+			return entry, true
+		}
+		if iter.done {
+			break
+		}
+	}
+	return FSEntry{}, false
+}
+
+// walkRoot returns the root of the walk.
+func (iter *FSIterator) walkRoot() (FSEntry, bool) {
+	info, err := fs.Stat(iter.fsys, iter.root)
+	if err != nil {
+		iter.done = true
+		iter.err = iter.handle(iter.root, err)
+		return FSEntry{}, false
+	}
+	entry := FSEntry{DirEntry: fs.FileInfoToDirEntry(info), Path: iter.root}
+	if info.IsDir() {
+		iter.pending = &dirFrame{path: iter.root, info: info}
+	}
+	return entry, true
+}
+
+// visit returns the entry of the directory as FSEntry when it is not filtered, and marks it pending when it is a
+// directory that must be walked.
+func (iter *FSIterator) visit(parent *dirFrame, e fs.DirEntry) (FSEntry, bool) {
+	if matchAny(iter.opts.Exclude, e.Name()) {
+		return FSEntry{}, false
+	}
+	entry := FSEntry{DirEntry: e, Path: path.Join(parent.path, e.Name()), Depth: parent.depth + 1}
+	if iter.opts.MaxDepth > 0 && entry.Depth > iter.opts.MaxDepth {
+		return FSEntry{}, false
+	}
+	var info fs.FileInfo
+	if e.Type()&fs.ModeSymlink != 0 && iter.opts.FollowSymlinks {
+		var err error
+		if info, err = iter.followSymlink(entry.Path); err != nil {
+			if err = iter.handle(entry.Path, err); err != nil {
+				iter.done, iter.err = true, err
+			}
+			return FSEntry{}, false
+		}
+		entry.DirEntry = fs.FileInfoToDirEntry(info)
+		if info.IsDir() && iter.isAncestor(info) {
+			return entry, true
+		}
+	}
+	if !entry.IsDir() {
+		return entry, len(iter.opts.Include) == 0 || matchAny(iter.opts.Include, e.Name())
+	}
+	if iter.opts.MaxDepth == 0 || entry.Depth < iter.opts.MaxDepth {
+		if info == nil && iter.opts.FollowSymlinks {
+			info, _ = e.Info()
+		}
+		iter.pending = &dirFrame{path: entry.Path, depth: entry.Depth, info: info}
+	}
+	return entry, true
+}
+
+// followSymlink returns the file info of the target of the symbolic link.
+func (iter *FSIterator) followSymlink(name string) (fs.FileInfo, error) {
+	info, err := fs.Stat(iter.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	if !isOSFileInfo(info) {
+		return nil, fmt.Errorf("iterator: following symbolic links requires os.DirFS")
+	}
+	return info, nil
+}
+
+// isOSFileInfo returns true when the file info is returned by the os package, which is how file systems returned by
+// os.DirFS are detected. os.SameFile only compares file info of the os package, for other file info it returns
+// false, even when compared with itself. Loop detection depends on os.SameFile, so symbolic links can only be
+// followed when it works.
+func isOSFileInfo(info fs.FileInfo) bool {
+	return os.SameFile(info, info)
+}
+
+// isAncestor returns true when the directory is one of the directories that are being walked.
+func (iter *FSIterator) isAncestor(info fs.FileInfo) bool {
+	for _, f := range iter.stack {
+		if f.info != nil && os.SameFile(f.info, info) {
+			return true
+		}
+	}
+	return false
+}
+
+// readDir reads the entries of the directory and pushes it on the stack.
+func (iter *FSIterator) readDir(dir *dirFrame) {
+	entries, err := fs.ReadDir(iter.fsys, dir.path)
+	if err != nil {
+		if err = iter.handle(dir.path, err); err != nil {
+			iter.done, iter.err = true, err
+		}
+		return
+	}
+	dir.entries = entries
+	iter.stack = append(iter.stack, dir)
+}
+
+// handle passes the error to the OnError callback, and returns the error that must stop the walk.
+func (iter *FSIterator) handle(name string, err error) error {
+	if iter.opts.OnError == nil {
+		return err
+	}
+	return iter.opts.OnError(name, err)
+}
+
+// matchAny returns true when the name matches at least one of the glob patterns.
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipDir skips the contents of the last returned entry when it is a directory. When the last returned entry is
+// not a directory, the remaining entries of its directory are skipped, like returning fs.SkipDir from an
+// fs.WalkDirFunc. SkipDir does nothing for a directory that is not walked anyway, because it is at MaxDepth or it
+// is a symbolic link to an ancestor. SkipDir must be called before Next is called again, so it can be called from a
+// closure in a pipeline that pulls one entry at a time, like Filter or ForEach.
+func (iter *FSIterator) SkipDir() {
+	if iter.lastDir {
+		iter.pending = nil
+		return
+	}
+	if len(iter.stack) > 0 {
+		iter.stack = iter.stack[:len(iter.stack)-1]
+	}
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. The error that stopped the walk is returned.
+func (iter *FSIterator) Error() error {
+	return iter.err
+}
+
+// Close stops the walk. After Close, Next returns false.
+func (iter *FSIterator) Close() error {
+	iter.done, iter.stack, iter.pending = true, nil, nil
+	return nil
+}
+
+// SizeHint returns exactly zero when the walk is done, otherwise nothing is known about the number of entries.
+func (iter *FSIterator) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	return 0, -1, false
+}
+
+// FromFS accepts a file system, the path of the root and FSOptions and returns an FSIterator that walks the file
+// tree at root lazily, with the semantics of fs.WalkDir: the root is returned first, directories are returned
+// before their contents, and entries are returned in lexical order. Directories are read when the iteration reaches
+// them. Symbolic links are not followed, unless the FollowSymlinks option is set.
+func FromFS(fsys fs.FS, root string, opts FSOptions) *FSIterator {
+	return &FSIterator{fsys: fsys, root: root, opts: opts}
+}
@@ -0,0 +1,245 @@
+package iterator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing/fstest"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleFromFS() {
+	fsys := fstest.MapFS{
+		"go.mod":                 {},
+		"main.go":                {},
+		"README.md":              {},
+		"internal/util.go":       {},
+		"internal/util_test.go":  {},
+		"vendor/lib/lib.go":      {},
+		"testdata/fixture/a.go":  {},
+		"testdata/fixture/b.txt": {},
+	}
+
+	walker := FromFS(fsys, ".", FSOptions{Include: []string{"*.go"}, Exclude: []string{"vendor"}})
+
+	// Skip testdata directories from inside the pipeline, and only keep the files.
+	files := Filter[FSEntry](walker, func(e FSEntry) bool {
+		if e.IsDir() && e.Name() == "testdata" {
+			walker.SkipDir()
+		}
+		return !e.IsDir()
+	})
+
+	_ = ForEach[FSEntry](files, func(e FSEntry) {
+		fmt.Println(e.Path)
+	})
+
+	// Output:
+	// internal/util.go
+	// internal/util_test.go
+	// main.go
+}
+
+// Tests
+
+// failingFS is a file system that fails to read the directory at fail.
+type failingFS struct {
+	fs.FS
+	fail string
+}
+
+var errReadDir = errors.New("cannot read directory")
+
+func (f failingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == f.fail {
+		return nil, errReadDir
+	}
+	return fs.ReadDir(f.FS, name)
+}
+
+type walkFixture struct {
+	fsys    fs.FS
+	tempDir string
+	opts    FSOptions
+	walker  *FSIterator
+	entries Iterable[FSEntry]
+	errors  []string
+}
+
+var fw *walkFixture
+
+func aFileSystemWithTheFiles(table *godog.Table) {
+	fsys := fstest.MapFS{}
+	for _, row := range table.Rows {
+		fsys[row.Cells[0].Value] = &fstest.MapFile{}
+	}
+	fw.fsys = fsys
+}
+
+func readingTheDirectoryFails(name string) {
+	fw.fsys = failingFS{FS: fw.fsys, fail: name}
+}
+
+func aDirectoryOnDiskWithASymbolicLinkLoop() error {
+	dir, err := os.MkdirTemp("", "walk")
+	if err != nil {
+		return err
+	}
+	fw.tempDir = dir
+	if err := os.MkdirAll(filepath.Join(dir, "a", "b"), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "a", "b", "file.txt"), nil, 0o644); err != nil {
+		return err
+	}
+	if err := os.Symlink("..", filepath.Join(dir, "a", "b", "up")); err != nil {
+		return err
+	}
+	if err := os.Symlink("a/b", filepath.Join(dir, "link")); err != nil {
+		return err
+	}
+	fw.fsys = os.DirFS(dir)
+	return nil
+}
+
+func aFileSystemWithASymbolicLink() {
+	fw.fsys = fstest.MapFS{
+		"dir/file.txt": {},
+		"link":         {Data: []byte("dir"), Mode: fs.ModeSymlink},
+	}
+}
+
+func symbolicLinksAreFollowed() {
+	fw.opts.FollowSymlinks = true
+}
+
+func aMaximumDepthOf(n int) {
+	fw.opts.MaxDepth = n
+}
+
+func theWalkIncludes(pattern string) {
+	fw.opts.Include = append(fw.opts.Include, pattern)
+}
+
+func theWalkExcludes(pattern string) {
+	fw.opts.Exclude = append(fw.opts.Exclude, pattern)
+}
+
+func walkErrorsAreCollectedByTheCallback() {
+	fw.opts.OnError = func(path string, err error) error {
+		fw.errors = append(fw.errors, path)
+		return nil
+	}
+}
+
+func fromFSIsCalledWithRoot(root string) {
+	fw.walker = FromFS(fw.fsys, root, fw.opts)
+	fw.entries = fw.walker
+}
+
+func directoriesNamedAreSkippedFromThePipeline(name string) {
+	fw.entries = Filter[FSEntry](fw.entries, func(e FSEntry) bool {
+		if e.IsDir() && e.Name() == name {
+			fw.walker.SkipDir()
+		}
+		return true
+	})
+}
+
+func theRestOfTheDirectoryIsSkippedAfter(name string) {
+	fw.entries = Filter[FSEntry](fw.entries, func(e FSEntry) bool {
+		if e.Name() == name {
+			fw.walker.SkipDir()
+		}
+		return true
+	})
+}
+
+func theWalkedPathsAre(expected string) error {
+	var paths []string
+	for e, b := fw.entries.Next(); b; e, b = fw.entries.Next() {
+		p := e.Path
+		if e.IsDir() {
+			p += "/"
+		}
+		paths = append(paths, p)
+	}
+	if got := strings.Join(paths, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theWalkedDepthsAre(expected string) error {
+	var depths []string
+	for e, b := fw.entries.Next(); b; e, b = fw.entries.Next() {
+		depths = append(depths, fmt.Sprint(e.Depth))
+	}
+	if got := strings.Join(depths, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func errorOfTheWalkReturnsTheReadError() error {
+	if err := fw.entries.Error(); !errors.Is(err, errReadDir) {
+		return fmt.Errorf("expected: %v got: %v", errReadDir, err)
+	}
+	return nil
+}
+
+func errorOfTheWalkReturnsAnError() error {
+	if fw.entries.Error() == nil {
+		return fmt.Errorf("expected an error")
+	}
+	return nil
+}
+
+func errorOfTheWalkReturnsNil() error {
+	if err := fw.entries.Error(); err != nil {
+		return fmt.Errorf("expected nil got: %v", err)
+	}
+	return nil
+}
+
+func theCallbackReceivedErrorsFor(expected string) error {
+	if got := strings.Join(fw.errors, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func initializeWalkScenario(ctx *godog.ScenarioContext) {
+	fw = &walkFixture{}
+	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
+		if fw.tempDir != "" {
+			return ctx, os.RemoveAll(fw.tempDir)
+		}
+		return ctx, nil
+	})
+	ctx.Step(`^a file system with the files:$`, aFileSystemWithTheFiles)
+	ctx.Step(`^reading the directory "([^"]*)" fails$`, readingTheDirectoryFails)
+	ctx.Step(`^a directory on disk with a symbolic link loop$`, aDirectoryOnDiskWithASymbolicLinkLoop)
+	ctx.Step(`^a file system with a symbolic link$`, aFileSystemWithASymbolicLink)
+	ctx.Step(`^symbolic links are followed$`, symbolicLinksAreFollowed)
+	ctx.Step(`^a maximum depth of (\d+)$`, aMaximumDepthOf)
+	ctx.Step(`^the walk includes "([^"]*)"$`, theWalkIncludes)
+	ctx.Step(`^the walk excludes "([^"]*)"$`, theWalkExcludes)
+	ctx.Step(`^walk errors are collected by the callback$`, walkErrorsAreCollectedByTheCallback)
+	ctx.Step(`^FromFS is called with root "([^"]*)"$`, fromFSIsCalledWithRoot)
+	ctx.Step(`^directories named "([^"]*)" are skipped from the pipeline$`, directoriesNamedAreSkippedFromThePipeline)
+	ctx.Step(`^the rest of the directory is skipped after "([^"]*)"$`, theRestOfTheDirectoryIsSkippedAfter)
+	ctx.Step(`^the walked paths are: "([^"]*)"$`, theWalkedPathsAre)
+	ctx.Step(`^the walked depths are: "([^"]*)"$`, theWalkedDepthsAre)
+	ctx.Step(`^Error\(\) of the walk returns the read error$`, errorOfTheWalkReturnsTheReadError)
+	ctx.Step(`^Error\(\) of the walk returns an error$`, errorOfTheWalkReturnsAnError)
+	ctx.Step(`^Error\(\) of the walk returns nil$`, errorOfTheWalkReturnsNil)
+	ctx.Step(`^the callback received errors for "([^"]*)"$`, theCallbackReceivedErrorsFor)
+}
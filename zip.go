@@ -0,0 +1,296 @@
+package iterator
+
+// Zip
+
+// ZipIterator is a struct the implements an Iterable that combines the values of two Iterables in pairs.
+type ZipIterator[A any, B any] struct {
+	// a is the Iterable this iterator pulls the first values of the pairs from.
+	a Iterable[A]
+	// b is the Iterable this iterator pulls the second values of the pairs from.
+	b Iterable[B]
+	// done contains true when one of the Iterables has no more values.
+	done bool
+}
+
+// Next returns the first or next Pair and true if a Pair is available.
+// The iteration stops as soon as one of the Iterables has no more values. A value is always pulled from the first
+// Iterable before the second, so when the second Iterable is shorter, one value of the first Iterable is discarded.
+// If no more values are available or an error has occurred then a zero value Pair and false is returned.
+func (iter *ZipIterator[A, B]) Next() (Pair[A, B], bool) {
+	var p Pair[A, B]
+	if iter.done {
+		return p, false
+	}
+	va, ok := iter.a.Next()
+	if !ok {
+		iter.done = true
+		return p, false
+	}
+	vb, ok := iter.b.Next()
+	if !ok {
+		iter.done = true
+		return p, false
+	}
+	return Pair[A, B]{First: va, Second: vb}, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. When both Iterables return an error, the error of the first Iterable wins.
+func (iter *ZipIterator[A, B]) Error() error {
+	if err := iter.a.Error(); err != nil {
+		return err
+	}
+	return iter.b.Error()
+}
+
+// Close closes both Iterables when they implement io.Closer.
+func (iter *ZipIterator[A, B]) Close() error {
+	return closeAll(iter.a, iter.b)
+}
+
+// SizeHint returns the size hint of the shortest Iterable.
+func (iter *ZipIterator[A, B]) SizeHint() (int, int, bool) {
+	if iter.done {
+		return exactHint(0)
+	}
+	lowerA, upperA, _ := SizeHint(iter.a)
+	lowerB, upperB, _ := SizeHint(iter.b)
+	lower, upper := min(lowerA, lowerB), minHint(upperA, upperB)
+	return lower, upper, lower == upper
+}
+
+// Zip accepts two Iterables and creates a ZipIterator that returns a Pair with a value of each Iterable until the
+// shortest Iterable has no more values.
+func Zip[A any, B any](a Iterable[A], b Iterable[B]) *ZipIterator[A, B] {
+	return &ZipIterator[A, B]{
+		a: a,
+		b: b,
+	}
+}
+
+// ZipLongest
+
+// ZipLongestIterator is a struct the implements an Iterable that combines the values of two Iterables in pairs
+// until both Iterables have no more values.
+type ZipLongestIterator[A any, B any] struct {
+	// a is the Iterable this iterator pulls the first values of the pairs from.
+	a Iterable[A]
+	// b is the Iterable this iterator pulls the second values of the pairs from.
+	b Iterable[B]
+	// fillA contains the value used as first value when a has no more values.
+	fillA A
+	// fillB contains the value used as second value when b has no more values.
+	fillB B
+	// aDone contains true when a has no more values.
+	aDone bool
+	// bDone contains true when b has no more values.
+	bDone bool
+}
+
+// Next returns the first or next Pair and true if a Pair is available.
+// When one Iterable has no more values, its fill value is used until the other Iterable has no more values.
+// The iteration stops immediately when one of the Iterables has an error.
+// If no more values are available or an error has occurred then a zero value Pair and false is returned.
+func (iter *ZipLongestIterator[A, B]) Next() (Pair[A, B], bool) {
+	p := Pair[A, B]{First: iter.fillA, Second: iter.fillB}
+	if !iter.aDone {
+		if va, ok := iter.a.Next(); ok {
+			p.First = va
+		} else {
+			iter.aDone = true
+		}
+	}
+	if !iter.bDone && iter.a.Error() == nil {
+		if vb, ok := iter.b.Next(); ok {
+			p.Second = vb
+		} else {
+			iter.bDone = true
+		}
+	}
+	if (iter.aDone && iter.bDone) || iter.Error() != nil {
+		iter.aDone, iter.bDone = true, true
+		return Pair[A, B]{}, false
+	}
+	return p, true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned. When both Iterables return an error, the error of the first Iterable wins.
+func (iter *ZipLongestIterator[A, B]) Error() error {
+	if err := iter.a.Error(); err != nil {
+		return err
+	}
+	return iter.b.Error()
+}
+
+// Close closes both Iterables when they implement io.Closer.
+func (iter *ZipLongestIterator[A, B]) Close() error {
+	return closeAll(iter.a, iter.b)
+}
+
+// SizeHint returns the size hint of the longest Iterable.
+func (iter *ZipLongestIterator[A, B]) SizeHint() (int, int, bool) {
+	lowerA, upperA, _ := SizeHint(iter.a)
+	lowerB, upperB, _ := SizeHint(iter.b)
+	lower, upper := max(lowerA, lowerB), maxHint(upperA, upperB)
+	return lower, upper, lower == upper
+}
+
+// ZipLongest accepts two Iterables and two fill values and creates a ZipLongestIterator that returns a Pair with a
+// value of each Iterable until the longest Iterable has no more values. The fill value of the shorter Iterable is
+// used for the missing values.
+func ZipLongest[A any, B any](a Iterable[A], b Iterable[B], fillA A, fillB B) *ZipLongestIterator[A, B] {
+	return &ZipLongestIterator[A, B]{
+		a:     a,
+		b:     b,
+		fillA: fillA,
+		fillB: fillB,
+	}
+}
+
+// Unzip
+
+// unzipBuffer contains the source of the unzip iterators and the values that are pulled from it by one iterator
+// but not yet returned by the other.
+type unzipBuffer[A any, B any] struct {
+	// srcItr is the Iterable the unzip iterators pull the pairs from.
+	srcItr Iterable[Pair[A, B]]
+	// first contains the buffered first values of the pairs.
+	first []A
+	// second contains the buffered second values of the pairs.
+	second []B
+	// firstClosed contains true when the UnzipFirstIterator is closed, its values are no longer buffered.
+	firstClosed bool
+	// secondClosed contains true when the UnzipSecondIterator is closed, its values are no longer buffered.
+	secondClosed bool
+}
+
+// pull pulls the next pair from the source and buffers the values of the iterators that are not closed. False is
+// returned when no pair is available.
+func (u *unzipBuffer[A, B]) pull() bool {
+	p, b := u.srcItr.Next()
+	if b {
+		if !u.firstClosed {
+			u.first = append(u.first, p.First)
+		}
+		if !u.secondClosed {
+			u.second = append(u.second, p.Second)
+		}
+	}
+	return b
+}
+
+// close marks one of the iterators as closed and closes the source when both iterators are closed.
+func (u *unzipBuffer[A, B]) close(closed *bool) error {
+	if *closed {
+		return nil
+	}
+	*closed = true
+	if !u.firstClosed || !u.secondClosed {
+		return nil
+	}
+	return Close(u.srcItr)
+}
+
+// pop removes the first value from the buffer and returns it.
+func pop[T any](buf *[]T) T {
+	var zero T
+	v := (*buf)[0]
+	(*buf)[0] = zero
+	*buf = (*buf)[1:]
+	return v
+}
+
+// UnzipFirstIterator is a struct the implements an Iterable that returns the first values of the pairs of the
+// Iterable provided to Unzip.
+type UnzipFirstIterator[A any, B any] struct {
+	// buf contains the buffer that is shared with the UnzipSecondIterator.
+	buf *unzipBuffer[A, B]
+}
+
+// Next returns the first or next value of A and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of A and false is returned.
+func (iter *UnzipFirstIterator[A, B]) Next() (A, bool) {
+	if iter.buf.firstClosed || len(iter.buf.first) == 0 && !iter.buf.pull() {
+		var a A
+		return a, false
+	}
+	return pop(&iter.buf.first), true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *UnzipFirstIterator[A, B]) Error() error {
+	return iter.buf.srcItr.Error()
+}
+
+// Close stops the iteration and discards the buffered values. The Iterable provided to Unzip is closed when it
+// implements io.Closer and the UnzipSecondIterator has been closed too, because both iterators share the same source.
+func (iter *UnzipFirstIterator[A, B]) Close() error {
+	iter.buf.first = nil
+	return iter.buf.close(&iter.buf.firstClosed)
+}
+
+// SizeHint returns the size hint of the Iterable provided to Unzip plus the number of buffered values.
+func (iter *UnzipFirstIterator[A, B]) SizeHint() (int, int, bool) {
+	if iter.buf.firstClosed {
+		return exactHint(0)
+	}
+	lower, upper, _ := SizeHint(iter.buf.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		return addHint(n, len(iter.buf.first))
+	})
+}
+
+// UnzipSecondIterator is a struct the implements an Iterable that returns the second values of the pairs of the
+// Iterable provided to Unzip.
+type UnzipSecondIterator[A any, B any] struct {
+	// buf contains the buffer that is shared with the UnzipFirstIterator.
+	buf *unzipBuffer[A, B]
+}
+
+// Next returns the first or next value of B and true if a value is available.
+// If no more values are available or an error has occurred then a zero value of B and false is returned.
+func (iter *UnzipSecondIterator[A, B]) Next() (B, bool) {
+	if iter.buf.secondClosed || len(iter.buf.second) == 0 && !iter.buf.pull() {
+		var b B
+		return b, false
+	}
+	return pop(&iter.buf.second), true
+}
+
+// Error returns nil after Next returned false when the iteration has completed successfully, otherwise
+// an error is returned.
+func (iter *UnzipSecondIterator[A, B]) Error() error {
+	return iter.buf.srcItr.Error()
+}
+
+// Close stops the iteration and discards the buffered values. The Iterable provided to Unzip is closed when it
+// implements io.Closer and the UnzipFirstIterator has been closed too, because both iterators share the same source.
+func (iter *UnzipSecondIterator[A, B]) Close() error {
+	iter.buf.second = nil
+	return iter.buf.close(&iter.buf.secondClosed)
+}
+
+// SizeHint returns the size hint of the Iterable provided to Unzip plus the number of buffered values.
+func (iter *UnzipSecondIterator[A, B]) SizeHint() (int, int, bool) {
+	if iter.buf.secondClosed {
+		return exactHint(0)
+	}
+	lower, upper, _ := SizeHint(iter.buf.srcItr)
+	return mapHint(lower, upper, func(n int) int {
+		return addHint(n, len(iter.buf.second))
+	})
+}
+
+// Unzip accepts an Iterable of pairs and creates two iterators that return the first and the second values of the
+// pairs. Both iterators pull from the provided Iterable through a shared buffer, the values pulled by one iterator
+// are buffered until the other iterator returns them. The buffer grows without bound when only one of the iterators
+// is iterated. Both iterators return the error of the provided Iterable. The provided Iterable is closed when both
+// iterators are closed, so a terminal operation on one iterator does not stop the other.
+func Unzip[A any, B any](iter Iterable[Pair[A, B]]) (*UnzipFirstIterator[A, B], *UnzipSecondIterator[A, B]) {
+	buf := &unzipBuffer[A, B]{
+		srcItr: iter,
+	}
+	return &UnzipFirstIterator[A, B]{buf: buf}, &UnzipSecondIterator[A, B]{buf: buf}
+}
@@ -0,0 +1,185 @@
+package iterator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// Examples
+
+func ExampleZip() {
+	// Zip pairs ids from a sequence with names from a slice.
+	zi := Zip[int, string](Sequence(1, 10), FromSlice([]string{"alice", "bob", "carol"}))
+
+	_ = ForEach[Pair[int, string]](zi, func(p Pair[int, string]) {
+		fmt.Println(p.First, p.Second)
+	})
+
+	// Output:
+	// 1 alice
+	// 2 bob
+	// 3 carol
+}
+
+// Tests
+
+type zipFixture struct {
+	second Iterable[string]
+	zipped Iterable[Pair[int, string]]
+	first  Iterable[int]
+	names  Iterable[string]
+	closes int
+}
+
+var zp zipFixture
+
+var (
+	errFirst  = errors.New("first iterable failed")
+	errSecond = errors.New("second iterable failed")
+)
+
+// failingIterator is an Iterable in an error state that returns err.
+type failingIterator[T any] struct {
+	err error
+}
+
+func (f *failingIterator[T]) Next() (T, bool) {
+	var t T
+	return t, false
+}
+
+func (f *failingIterator[T]) Error() error {
+	return f.err
+}
+
+func aSecondIterableWithTheFollowingStrings(table *godog.Table) {
+	zp.second = FromSlice(toSliceOfStrings(table))
+}
+
+func aSecondIterableInAnErrorState() {
+	zp.second = &failingIterator[string]{err: errSecond}
+}
+
+func aFirstIterableInAnErrorState() {
+	t.resultingIntIterator = &failingIterator[int]{err: errFirst}
+}
+
+func zipIsCalled() {
+	zp.zipped = Zip(t.resultingIntIterator, zp.second)
+}
+
+func zipLongestIsCalledWithFillValuesAnd(fillA int, fillB string) {
+	zp.zipped = ZipLongest(t.resultingIntIterator, zp.second, fillA, fillB)
+}
+
+func theZippedPairsAre(expected string) error {
+	var results []string
+	for p, b := zp.zipped.Next(); b; p, b = zp.zipped.Next() {
+		results = append(results, fmt.Sprintf("%d:%s", p.First, p.Second))
+	}
+	if got := strings.Join(results, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func errorOfTheZippedIteratorReturnsNil() error {
+	if err := zp.zipped.Error(); err != nil {
+		return fmt.Errorf("expected nil but got: %v", err)
+	}
+	return nil
+}
+
+func errorOfTheZippedIteratorReturnsTheErrorOfTheFirstIterable() error {
+	if err := zp.zipped.Error(); err != errFirst {
+		return fmt.Errorf("expected: %v got: %v", errFirst, err)
+	}
+	return nil
+}
+
+func errorOfTheZippedIteratorReturnsTheErrorOfTheSecondIterable() error {
+	if err := zp.zipped.Error(); err != errSecond {
+		return fmt.Errorf("expected: %v got: %v", errSecond, err)
+	}
+	return nil
+}
+
+func unzipIsCalled() {
+	zp.first, zp.names = Unzip[int, string](zp.zipped)
+}
+
+func theZippedIterableCountsTheCallsToClose() {
+	zp.zipped = OnClose(zp.zipped, func() error {
+		zp.closes++
+		return nil
+	})
+}
+
+func firstIsCalledOnTheFirstUnzippedIterable() error {
+	_, _, err := First(zp.first)
+	return err
+}
+
+func theZippedIterableIsClosedTimes(n int) error {
+	if zp.closes != n {
+		return fmt.Errorf("expected: %v got: %v", n, zp.closes)
+	}
+	return nil
+}
+
+func theFirstUnzippedIterableReturns(expected string) error {
+	var results []string
+	for v, b := zp.first.Next(); b; v, b = zp.first.Next() {
+		results = append(results, strconv.Itoa(v))
+	}
+	if got := strings.Join(results, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func theSecondUnzippedIterableReturns(expected string) error {
+	results, err := ToSlice(zp.names)
+	if err != nil {
+		return err
+	}
+	if got := strings.Join(results, ","); got != expected {
+		return fmt.Errorf("expected: %v got: %v", expected, got)
+	}
+	return nil
+}
+
+func bothUnzippedIterablesReturnTheErrorOfTheFirstIterable() error {
+	if _, err := ToSlice(zp.first); err != errFirst {
+		return fmt.Errorf("expected: %v got: %v", errFirst, err)
+	}
+	if _, err := ToSlice(zp.names); err != errFirst {
+		return fmt.Errorf("expected: %v got: %v", errFirst, err)
+	}
+	return nil
+}
+
+func initializeZipScenario(ctx *godog.ScenarioContext) {
+	zp = zipFixture{}
+
+	ctx.Step(`^a second Iterable with the following strings:$`, aSecondIterableWithTheFollowingStrings)
+	ctx.Step(`^a first Iterable in an error state$`, aFirstIterableInAnErrorState)
+	ctx.Step(`^a second Iterable in an error state$`, aSecondIterableInAnErrorState)
+	ctx.Step(`^Zip is called$`, zipIsCalled)
+	ctx.Step(`^ZipLongest is called with fill values (-?\d+) and "([^"]*)"$`, zipLongestIsCalledWithFillValuesAnd)
+	ctx.Step(`^the zipped pairs are: "([^"]*)"$`, theZippedPairsAre)
+	ctx.Step(`^Error\(\) of the zipped iterator returns nil$`, errorOfTheZippedIteratorReturnsNil)
+	ctx.Step(`^Error\(\) of the zipped iterator returns the error of the first Iterable$`, errorOfTheZippedIteratorReturnsTheErrorOfTheFirstIterable)
+	ctx.Step(`^Error\(\) of the zipped iterator returns the error of the second Iterable$`, errorOfTheZippedIteratorReturnsTheErrorOfTheSecondIterable)
+	ctx.Step(`^Unzip is called$`, unzipIsCalled)
+	ctx.Step(`^the zipped Iterable counts the calls to Close$`, theZippedIterableCountsTheCallsToClose)
+	ctx.Step(`^First is called on the first unzipped Iterable$`, firstIsCalledOnTheFirstUnzippedIterable)
+	ctx.Step(`^the zipped Iterable is closed (\d+) times$`, theZippedIterableIsClosedTimes)
+	ctx.Step(`^the first unzipped Iterable returns: "([^"]*)"$`, theFirstUnzippedIterableReturns)
+	ctx.Step(`^the second unzipped Iterable returns: "([^"]*)"$`, theSecondUnzippedIterableReturns)
+	ctx.Step(`^both unzipped Iterables return the error of the first Iterable$`, bothUnzippedIterablesReturnTheErrorOfTheFirstIterable)
+}